@@ -0,0 +1,144 @@
+package acctest
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BlockStyle int
+
+const (
+	// BlockStyleStatic renders each item as its own nested block
+	BlockStyleStatic BlockStyle = iota
+	// BlockStyleDynamic renders the items as a single dynamic block
+	BlockStyleDynamic
+)
+
+func (s BlockStyle) String() string {
+	switch s {
+	case BlockStyleStatic:
+		return "static"
+	case BlockStyleDynamic:
+		return "dynamic"
+
+	default:
+		return fmt.Sprintf("BlockStyle(%d)", int(s))
+	}
+}
+
+// NestedBlocks may be used as a field value to render repeated nested blocks, such as `ingress {}` or `rule {}`,
+// rather than an attribute.  The field's key is used as the block type.
+type NestedBlocks struct {
+	Items []map[string]interface{}
+	Style BlockStyle
+
+	// ForEach is the expression a dynamic block iterates over.  If empty, the items are rendered inline as the
+	// for_each value.  This has no effect on static blocks.
+	ForEach ConfigLiteral
+}
+
+// StaticBlocks returns a NestedBlocks that will render each item as its own nested block
+func StaticBlocks(items []map[string]interface{}) NestedBlocks {
+	return NestedBlocks{Items: items, Style: BlockStyleStatic}
+}
+
+// DynamicBlocks returns a NestedBlocks that will render as a dynamic block iterating over local.{localName}, along with
+// the field map defining that local.  The returned field map must be compiled into a locals block alongside the
+// config using the blocks, e.g. with CompileLocalsConfig.
+//
+// If localName is empty, the items will be rendered inline as the dynamic block's for_each value and the returned
+// field map will be nil.
+func DynamicBlocks(localName string, items []map[string]interface{}) (NestedBlocks, map[string]interface{}) {
+	return NewNestedBlocks(BlockStyleDynamic, localName, items)
+}
+
+// NewNestedBlocks allows toggling between static and dynamic rendering of the same items.  See DynamicBlocks for
+// details on the returned field map, which will be nil for the static style.  CompileLocalsConfig renders nothing for
+// a nil field map, so it may be called regardless of style.
+func NewNestedBlocks(style BlockStyle, localName string, items []map[string]interface{}) (NestedBlocks, map[string]interface{}) {
+	nb := NestedBlocks{Items: items, Style: style}
+	if style != BlockStyleDynamic || localName == "" {
+		return nb, nil
+	}
+	nb.ForEach = ConfigLiteral(fmt.Sprintf("local.%s", localName))
+	return nb, map[string]interface{}{localName: items}
+}
+
+// CompileNestedBlocks renders the provided NestedBlocks using blockType as the type of each block
+func CompileNestedBlocks(blockType string, nb NestedBlocks) string {
+	switch nb.Style {
+	case BlockStyleStatic:
+		return compileStaticBlocks(blockType, nb.Items)
+	case BlockStyleDynamic:
+		forEach := string(nb.ForEach)
+		if forEach == "" {
+			forEach = ConfigValue(nb.Items)
+		}
+		return compileDynamicBlock(blockType, forEach, nb.Items)
+
+	default:
+		panic(fmt.Sprintf("Unable to handle nested block style %s", nb.Style))
+	}
+}
+
+func compileStaticBlocks(blockType string, items []map[string]interface{}) string {
+	blocks := make([]string, len(items))
+	for i, item := range items {
+		blocks[i] = fmt.Sprintf("%s {\n%s}", blockType, compileFields(item))
+	}
+	return strings.Join(blocks, "\n")
+}
+
+func compileDynamicBlock(blockType, forEach string, items []map[string]interface{}) string {
+	const f = `dynamic %q {
+for_each = %s
+content {
+%s}
+}`
+
+	var (
+		content = ""
+		keys    = make(map[string]interface{})
+	)
+
+	// build the union of keys across all items, as each item may not define every key.
+	for _, item := range items {
+		for k := range item {
+			keys[k] = nil
+		}
+	}
+
+	for _, k := range SortedKeys(keys) {
+		var (
+			inAll     = true
+			allBlocks = true
+			subItems  = make([]map[string]interface{}, 0)
+		)
+		for _, item := range items {
+			v, ok := item[k]
+			if !ok {
+				inAll = false
+				continue
+			}
+			if nb, ok := v.(NestedBlocks); ok {
+				subItems = append(subItems, nb.Items...)
+			} else {
+				allBlocks = false
+			}
+		}
+
+		if allBlocks {
+			subForEach := fmt.Sprintf("%s.value.%s", blockType, k)
+			if !inAll {
+				subForEach = fmt.Sprintf("lookup(%s.value, %q, [])", blockType, k)
+			}
+			content = fmt.Sprintf("%s%s\n", content, compileDynamicBlock(k, subForEach, subItems))
+		} else if inAll {
+			content = fmt.Sprintf("%s%s = %s.value.%s\n", content, k, blockType, k)
+		} else {
+			content = fmt.Sprintf("%s%s = lookup(%s.value, %q, null)\n", content, k, blockType, k)
+		}
+	}
+
+	return fmt.Sprintf(f, blockType, forEach, content)
+}
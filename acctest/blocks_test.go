@@ -0,0 +1,40 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestNestedBlocks(t *testing.T) {
+	items := []map[string]interface{}{
+		{
+			"from_port": 80,
+			"protocol":  "tcp",
+			"cidr":      acctest.StaticBlocks([]map[string]interface{}{{"block": "10.0.0.0/8"}}),
+		},
+		{
+			"from_port":   443,
+			"protocol":    "tcp",
+			"description": "https",
+		},
+	}
+
+	for _, style := range []acctest.BlockStyle{acctest.BlockStyleStatic, acctest.BlockStyleDynamic} {
+		t.Run(style.String(), func(t *testing.T) {
+			rules, locals := acctest.NewNestedBlocks(style, "rules", items)
+			conf := acctest.JoinConfigs(
+				acctest.CompileLocalsConfig(locals),
+				acctest.CompileResourceConfig("thing_firewall", "test", map[string]interface{}{
+					"name": "test",
+					"rule": rules,
+				}),
+			)
+			if _, err := acctest.JoinConfigsChecked(conf); err != nil {
+				t.Log(conf)
+				t.Fatalf("Generated config failed lint: %v", err)
+			}
+			acctest.AssertConfigSnapshot(t, "", conf)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package acctest
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"unicode"
+)
+
+var annotateCallers atomic.Bool
+
+// SetAnnotateCallers enables or disables caller annotation, returning the previous setting.  When enabled,
+// CompileConfig and the functions built on it prefix each rendered block with a comment naming the function outside
+// this package that compiled it.  The setting is process-wide, and safe to change while tests run in parallel.
+func SetAnnotateCallers(enabled bool) bool {
+	return annotateCallers.Swap(enabled)
+}
+
+// AnnotateCallers returns true if caller annotation has been enabled with SetAnnotateCallers
+func AnnotateCallers() bool {
+	return annotateCallers.Load()
+}
+
+// Commented may be used as a field value to render a leading comment above the field.  When rendered inline, for
+// example as a value within a map, the comment is rendered as a /* */ comment preceding the value.
+type Commented struct {
+	Comment string
+	Value   interface{}
+}
+
+// commentLines splits the provided comment into lines, removing any control characters that could otherwise break out
+// of the comment.
+func commentLines(comment string) []string {
+	comment = strings.ReplaceAll(comment, "\r\n", "\n")
+	comment = strings.ReplaceAll(comment, "\r", "\n")
+	lines := strings.Split(strings.TrimRight(comment, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRightFunc(strings.Map(func(r rune) rune {
+			if r != '\t' && unicode.IsControl(r) {
+				return -1
+			}
+			return r
+		}, line), unicode.IsSpace)
+	}
+	return lines
+}
+
+// ConfigComment renders the provided text as one or more `#` comment lines.  Multi-line text produces one comment line
+// per line of text.
+func ConfigComment(comment string) string {
+	lines := commentLines(comment)
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = "#"
+		} else {
+			lines[i] = fmt.Sprintf("# %s", line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// InlineConfigComment renders the provided text as a single /* */ comment, safe for use within an expression.
+func InlineConfigComment(comment string) string {
+	lines := commentLines(comment)
+	inner := strings.ReplaceAll(strings.Join(lines, " "), "*/", "* /")
+	return fmt.Sprintf("/* %s */", strings.TrimSpace(inner))
+}
+
+// CommentConfig prefixes the provided config with the provided comment
+func CommentConfig(comment, conf string) string {
+	return fmt.Sprintf("%s\n%s", ConfigComment(comment), strings.TrimLeft(conf, "\n"))
+}
+
+// ConfigHeaderComment returns a comment naming the running test and, if greater than zero, the test step the config
+// is used in.
+func ConfigHeaderComment(t testing.TB, step int) string {
+	if step > 0 {
+		return ConfigComment(fmt.Sprintf("Test: %s\nStep: %d", t.Name(), step))
+	}
+	return ConfigComment(fmt.Sprintf("Test: %s", t.Name()))
+}
+
+// AnnotateConfig prefixes the provided config with the comment produced by ConfigHeaderComment, so that logged configs
+// identify the test and step they were used in.
+func AnnotateConfig(t testing.TB, step int, conf string) string {
+	return fmt.Sprintf("%s\n%s", ConfigHeaderComment(t, step), strings.TrimLeft(conf, "\n"))
+}
+
+var acctestFuncPrefix = reflect.TypeOf(Commented{}).PkgPath() + "."
+
+// callerComment returns a comment line naming the first caller outside this package if AnnotateCallers is true.
+func callerComment() string {
+	if !AnnotateCallers() {
+		return ""
+	}
+
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !strings.HasPrefix(frame.Function, acctestFuncPrefix) {
+			return fmt.Sprintf("%s\n", ConfigComment(fmt.Sprintf("generated by %s", frame.Function)))
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package acctest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestConfigComment(t *testing.T) {
+	assert.Equal(t, "# one\n#\n# two", acctest.ConfigComment("one\r\n\r\ntwo\x00\n"))
+	assert.Equal(t, "/* a * / b */", acctest.InlineConfigComment("a */\nb"))
+}
+
+func TestCommentedConfig(t *testing.T) {
+	conf := acctest.AnnotateConfig(t, 1, acctest.JoinConfigs(
+		acctest.CommentConfig("the fish\nunder test", acctest.CompileResourceConfig("thing_fish", "test", map[string]interface{}{
+			"name": acctest.Commented{Comment: "name must be unique", Value: "fish"},
+			"tags": map[string]interface{}{
+				"owner": acctest.Commented{Comment: "ignored */ by the api", Value: "me"},
+			},
+		})),
+	))
+	if _, err := acctest.LintConfig(conf); err != nil {
+		t.Log(conf)
+		t.Fatalf("Generated config failed to parse: %v", err)
+	}
+	acctest.AssertConfigSnapshot(t, "", conf)
+}
+
+func TestAnnotateCallers(t *testing.T) {
+	prev := acctest.SetAnnotateCallers(true)
+	t.Cleanup(func() { acctest.SetAnnotateCallers(prev) })
+	assert.True(t, acctest.AnnotateCallers())
+	conf := acctest.CompileResourceConfig("thing_fish", "test", nil)
+	if !strings.Contains(conf, "# generated by github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest_test.TestAnnotateCallers") {
+		t.Logf("Expected caller annotation, saw %q", conf)
+		t.Fail()
+	}
+}
@@ -1,7 +1,12 @@
 package acctest
 
 import (
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -13,6 +18,12 @@ type ConfigLiteral string
 
 type ConfigValueFunc func(interface{}) string
 
+// ConfigValuer may be implemented by types that render their own config value.  It is consulted by ConfigValue when
+// no ConfigValueFunc has been registered for the type.
+type ConfigValuer interface {
+	ConfigValue() string
+}
+
 var (
 	configValueFuncsMu sync.RWMutex
 	configValueFuncs   map[string]ConfigValueFunc
@@ -53,11 +64,52 @@ func DefaultConfigValueFuncs() map[string]ConfigValueFunc {
 			}
 		},
 
+		// nested blocks used as values, e.g. within a local, are rendered as a list of their items
+		util.KeyFN(NestedBlocks{}): func(v interface{}) string {
+			return ConfigValue(v.(NestedBlocks).Items)
+		},
+
+		// commented values rendered inline, e.g. within a map, use an inline comment
+		util.KeyFN(Commented{}): func(v interface{}) string {
+			c := v.(Commented)
+			return fmt.Sprintf("%s %s", InlineConfigComment(c.Comment), ConfigValue(c.Value))
+		},
+
 		// time values
 
 		util.KeyFN(time.Nanosecond): func(v interface{}) string {
 			return ConfigValue(v.(time.Duration).String())
 		},
+		util.KeyFN(time.Time{}): func(v interface{}) string {
+			return ConfigValue(v.(time.Time).Format(time.RFC3339))
+		},
+
+		// network values
+
+		util.KeyFN(net.IP{}): func(v interface{}) string {
+			return ConfigValue(v.(net.IP).String())
+		},
+		util.KeyFN(net.IPNet{}): func(v interface{}) string {
+			ipn := v.(net.IPNet)
+			return ConfigValue(ipn.String())
+		},
+		util.KeyFN(&net.IPNet{}): func(v interface{}) string {
+			return ConfigValue(v.(*net.IPNet).String())
+		},
+		util.KeyFN(netip.Addr{}): func(v interface{}) string {
+			return ConfigValue(v.(netip.Addr).String())
+		},
+		util.KeyFN(netip.Prefix{}): func(v interface{}) string {
+			return ConfigValue(v.(netip.Prefix).String())
+		},
+		util.KeyFN(&url.URL{}): func(v interface{}) string {
+			return ConfigValue(v.(*url.URL).String())
+		},
+
+		// raw json is decoded by terraform
+		util.KeyFN(json.RawMessage{}): func(v interface{}) string {
+			return fmt.Sprintf("jsondecode(%s)", ConfigValue(string(v.(json.RawMessage))))
+		},
 
 		// slices
 
@@ -108,15 +160,17 @@ func DefaultConfigValueFuncs() map[string]ConfigValueFunc {
 
 		util.KeyFN(make(map[string]interface{})): func(v interface{}) string {
 			inner := "{"
-			for k, v := range v.(map[string]interface{}) {
-				inner = fmt.Sprintf("%s\n%s = %s", inner, k, ConfigValue(v))
+			m := v.(map[string]interface{})
+			for _, k := range SortedKeys(m) {
+				inner = fmt.Sprintf("%s\n%s = %s", inner, k, ConfigValue(m[k]))
 			}
 			return fmt.Sprintf("%s\n}", inner)
 		},
 		util.KeyFN(make(map[string]string)): func(v interface{}) string {
 			inner := "{"
-			for k, v := range v.(map[string]string) {
-				inner = fmt.Sprintf("%s\n%s = %s", inner, k, ConfigValue(v))
+			m := v.(map[string]string)
+			for _, k := range SortedKeys(m) {
+				inner = fmt.Sprintf("%s\n%s = %s", inner, k, ConfigValue(m[k]))
 			}
 			return fmt.Sprintf("%s\n}", inner)
 		},
@@ -127,10 +181,23 @@ func init() {
 	configValueFuncs = DefaultConfigValueFuncs()
 }
 
-// ConfigValue attempts to convert the provided input to a Terraform config safe representation of its value
+// ConfigValue attempts to convert the provided input to a Terraform config safe representation of its value.
+//
+// If no func has been registered for the input's type, types implementing ConfigValuer render themselves, and types
+// implementing encoding.TextMarshaler or fmt.Stringer are rendered as a string.
 func ConfigValue(in interface{}) string {
 	if fn, ok := GetConfigValueFunc(in); ok {
 		return fn(in)
+	} else if cv, ok := in.(ConfigValuer); ok {
+		return cv.ConfigValue()
+	} else if tm, ok := in.(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		if err != nil {
+			panic(fmt.Sprintf("Unable to marshal config value of type %T to text: %v", in, err))
+		}
+		return ConfigValue(string(b))
+	} else if s, ok := in.(fmt.Stringer); ok {
+		return ConfigValue(s.String())
 	} else {
 		panic(fmt.Sprintf("Unable to handle config values of type %T", in))
 	}
@@ -140,18 +207,36 @@ func JoinConfigs(confs ...string) string {
 	return strings.Join(confs, "\n")
 }
 
+// CompileConfig renders a block with the provided header, containing every field in the merged field maps.  Fields are
+// rendered in key order so that the output is deterministic.
 func CompileConfig(header string, fieldMaps ...map[string]interface{}) string {
 	const f = `
-%s {
+%s%s {
 %s
 }`
 
-	fields := ""
-	for k, v := range MergeMaps(fieldMaps...) {
-		fields = fmt.Sprintf("%s%s = %s\n", fields, k, ConfigValue(v))
+	return fmt.Sprintf(f, callerComment(), header, compileFields(MergeMaps(fieldMaps...)))
+}
+
+// compileFields renders each field in key order, one per line
+func compileFields(fields map[string]interface{}) string {
+	out := ""
+	for _, k := range SortedKeys(fields) {
+		out = fmt.Sprintf("%s%s\n", out, compileField(k, fields[k]))
 	}
+	return out
+}
 
-	return fmt.Sprintf(f, header, fields)
+// compileField renders a single field, either as an attribute assignment or, for NestedBlocks values, as one or
+// more nested blocks.  Commented values are preceded by their comment.
+func compileField(name string, v interface{}) string {
+	if c, ok := v.(Commented); ok {
+		return fmt.Sprintf("%s\n%s", ConfigComment(c.Comment), compileField(name, c.Value))
+	}
+	if nb, ok := v.(NestedBlocks); ok {
+		return CompileNestedBlocks(name, nb)
+	}
+	return fmt.Sprintf("%s = %s", name, ConfigValue(v))
 }
 
 func ProviderHeader(name string) string {
@@ -195,6 +280,11 @@ func CompileDataSourceConfig(dataSourceType, dataSourceName string, fieldMaps ..
 	)
 }
 
+// CompileLocalsConfig renders a locals block containing every field in the merged field maps.  If there are no
+// fields, an empty string is returned rather than an empty block.
 func CompileLocalsConfig(fieldMaps ...map[string]interface{}) string {
+	if len(MergeMaps(fieldMaps...)) == 0 {
+		return ""
+	}
 	return CompileConfig("locals", fieldMaps...)
 }
@@ -1,8 +1,13 @@
 package acctest_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
 	"testing"
 	"time"
 
@@ -14,6 +19,12 @@ import (
 	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
 )
 
+type stringer struct{}
+
+func (stringer) String() string {
+	return "stringer"
+}
+
 func TestConfigValue_Defaults(t *testing.T) {
 	type convTest struct {
 		name string
@@ -82,6 +93,46 @@ EOD
 			in:   time.Nanosecond,
 			out:  `"1ns"`,
 		},
+		{
+			name: "time-to-rfc3339",
+			in:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			out:  `"2024-01-02T03:04:05Z"`,
+		},
+		{
+			name: "ip",
+			in:   net.ParseIP("10.0.0.1"),
+			out:  `"10.0.0.1"`,
+		},
+		{
+			name: "ipnet",
+			in:   &net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 32)},
+			out:  `"10.0.0.0/8"`,
+		},
+		{
+			name: "netip-addr",
+			in:   netip.MustParseAddr("::1"),
+			out:  `"::1"`,
+		},
+		{
+			name: "netip-prefix",
+			in:   netip.MustParsePrefix("10.0.0.0/8"),
+			out:  `"10.0.0.0/8"`,
+		},
+		{
+			name: "url",
+			in:   &url.URL{Scheme: "https", Host: "example.com", Path: "/path"},
+			out:  `"https://example.com/path"`,
+		},
+		{
+			name: "text-marshaler",
+			in:   big.NewFloat(1.5),
+			out:  `"1.5"`,
+		},
+		{
+			name: "stringer",
+			in:   stringer{},
+			out:  `"stringer"`,
+		},
 		{
 			name: "slice-interface",
 			in:   []interface{}{"hello", 5},
@@ -205,3 +256,23 @@ func TestConfigValue_Set(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestConfigValue_RawJSON(t *testing.T) {
+	v := acctest.ConfigValue(json.RawMessage(`{"a":1}`))
+	if v != `jsondecode("{\"a\":1}")` {
+		t.Logf("Unexpected raw json rendering: %s", v)
+		t.Fail()
+	}
+}
+
+func TestConfigValue_Sets(t *testing.T) {
+	v := acctest.ConfigValue(acctest.Set[string]{"b", "a", "b"})
+	assert.Equal(t, "[\n\"a\",\n\"b\"\n]", v)
+
+	v = acctest.ConfigValue(acctest.ToSet[map[string]interface{}]{
+		{"name": "b"},
+		{"name": "a"},
+		{"name": "b"},
+	})
+	assert.Equal(t, "toset([\n{\nname = \"a\"\n},\n{\nname = \"b\"\n}\n])", v)
+}
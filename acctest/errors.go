@@ -0,0 +1,67 @@
+package acctest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+var (
+	ErrConfigParseFailed = errors.New("config parse failed")
+	ErrConfigLintFailed  = errors.New("config lint failed")
+
+	ErrRecorderModeInvalid   = errors.New("recorder mode invalid")
+	ErrNoMatchingInteraction = errors.New("no matching recorded interaction")
+
+	ErrSweeperNotFound        = errors.New("sweeper not found")
+	ErrSweeperDependencyCycle = errors.New("sweeper dependency cycle")
+	ErrSweeperFailed          = errors.New("sweeper failed")
+
+	ErrFunctionArgumentInvalid = errors.New("function argument invalid")
+	ErrFunctionRunFailed       = errors.New("function run failed")
+)
+
+func ConfigParseFailedError(err error) error {
+	return fmt.Errorf("%w: %v", ErrConfigParseFailed, err)
+}
+
+func IsConfigParseFailedError(err error) bool {
+	return util.MatchError(err, ErrConfigParseFailed)
+}
+
+func ConfigLintFailedError(issues LintIssues) error {
+	return fmt.Errorf("%w: %v", ErrConfigLintFailed, issues)
+}
+
+func IsConfigLintFailedError(err error) bool {
+	return util.MatchError(err, ErrConfigLintFailed)
+}
+
+func IsRecorderModeInvalidError(err error) bool {
+	return util.MatchError(err, ErrRecorderModeInvalid)
+}
+
+func IsNoMatchingInteractionError(err error) bool {
+	return util.MatchError(err, ErrNoMatchingInteraction)
+}
+
+func IsSweeperNotFoundError(err error) bool {
+	return util.MatchError(err, ErrSweeperNotFound)
+}
+
+func IsSweeperDependencyCycleError(err error) bool {
+	return util.MatchError(err, ErrSweeperDependencyCycle)
+}
+
+func IsSweeperFailedError(err error) bool {
+	return util.MatchError(err, ErrSweeperFailed)
+}
+
+func IsFunctionArgumentInvalidError(err error) bool {
+	return util.MatchError(err, ErrFunctionArgumentInvalid)
+}
+
+func IsFunctionRunFailedError(err error) bool {
+	return util.MatchError(err, ErrFunctionRunFailed)
+}
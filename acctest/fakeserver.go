@@ -0,0 +1,322 @@
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// FakeCollectionConfig describes the behavior of a FakeCollection
+type FakeCollectionConfig struct {
+	// IDField is the name of the field the generated ID is stored under in each object.  Defaults to "id"
+	IDField string
+	// IDFunc generates the ID of newly created objects.  Defaults to sequential integers, starting at 1
+	IDFunc func() string
+
+	// ConsistencyDelay simulates an eventually consistent backend.  Newly created objects are not readable until the
+	// delay has passed, and deleted objects remain readable until the delay has passed.
+	ConsistencyDelay time.Duration
+	// Now returns the current time, against which ConsistencyDelay is measured.  Defaults to time.Now, and may be
+	// replaced to advance time deterministically in tests.
+	Now func() time.Time
+
+	// ErrorRate is the fraction, between 0 and 1, of requests to the collection that will be answered with ErrorStatus
+	ErrorRate float64
+	// ErrorStatus is the status code returned for injected errors.  Defaults to http.StatusInternalServerError
+	ErrorStatus int
+	// Seed is used to seed the source deciding which requests receive injected errors
+	Seed int64
+}
+
+type fakeObject struct {
+	data      map[string]interface{}
+	version   int
+	visibleAt time.Time
+	deletedAt time.Time
+}
+
+func (o *fakeObject) etag() string {
+	return strconv.Quote(strconv.Itoa(o.version))
+}
+
+// FakeCollection is an in-memory collection of JSON objects served by a FakeServer at /{name} and /{name}/{id}
+type FakeCollection struct {
+	mu      sync.Mutex
+	name    string
+	conf    FakeCollectionConfig
+	rand    *rand.Rand
+	seq     int
+	objects map[string]*fakeObject
+}
+
+func newFakeCollection(name string, conf FakeCollectionConfig) *FakeCollection {
+	c := &FakeCollection{
+		name:    name,
+		conf:    conf,
+		rand:    rand.New(rand.NewSource(conf.Seed)),
+		objects: make(map[string]*fakeObject),
+	}
+	if c.conf.IDField == "" {
+		c.conf.IDField = "id"
+	}
+	if c.conf.ErrorStatus == 0 {
+		c.conf.ErrorStatus = http.StatusInternalServerError
+	}
+	if c.conf.Now == nil {
+		c.conf.Now = time.Now
+	}
+	if c.conf.IDFunc == nil {
+		c.conf.IDFunc = func() string {
+			c.seq++
+			return strconv.Itoa(c.seq)
+		}
+	}
+	return c
+}
+
+// Name returns the name of this collection, which is also its URL path
+func (c *FakeCollection) Name() string {
+	return c.name
+}
+
+// Put creates or replaces the object with the provided id, bypassing any configured consistency delay.  This may be
+// used to seed the collection or to simulate drift.
+func (c *FakeCollection) Put(id string, obj map[string]interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.put(id, obj, c.conf.Now())
+}
+
+// Get returns a copy of the object with the provided id, ignoring any configured consistency delay
+func (c *FakeCollection) Get(id string) (map[string]interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o, ok := c.objects[id]
+	if !ok || !o.deletedAt.IsZero() {
+		return nil, false
+	}
+	return copyFakeData(o.data), true
+}
+
+// Delete removes the object with the provided id, bypassing any configured consistency delay.  This may be used to
+// simulate resources deleted outside of Terraform.
+func (c *FakeCollection) Delete(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.objects[id]
+	delete(c.objects, id)
+	return ok
+}
+
+// IDs returns the sorted IDs of every object in the collection, ignoring any configured consistency delay
+func (c *FakeCollection) IDs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]string, 0, len(c.objects))
+	for id, o := range c.objects {
+		if o.deletedAt.IsZero() {
+			out = append(out, id)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (c *FakeCollection) put(id string, obj map[string]interface{}, visibleAt time.Time) *fakeObject {
+	data := copyFakeData(obj)
+	data[c.conf.IDField] = id
+	o := &fakeObject{data: data, version: 1, visibleAt: visibleAt}
+	if prev, ok := c.objects[id]; ok && prev.deletedAt.IsZero() {
+		o.version = prev.version + 1
+	}
+	c.objects[id] = o
+	return o
+}
+
+// visible returns the object with the provided id if it is readable at the provided time
+func (c *FakeCollection) visible(id string, now time.Time) (*fakeObject, bool) {
+	o, ok := c.objects[id]
+	if !ok || now.Before(o.visibleAt) {
+		return nil, false
+	}
+	if !o.deletedAt.IsZero() {
+		if now.Before(o.deletedAt.Add(c.conf.ConsistencyDelay)) {
+			return o, true
+		}
+		delete(c.objects, id)
+		return nil, false
+	}
+	return o, true
+}
+
+func (c *FakeCollection) serve(w http.ResponseWriter, r *http.Request, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conf.ErrorRate > 0 && c.rand.Float64() < c.conf.ErrorRate {
+		writeFakeError(w, c.conf.ErrorStatus, "injected error")
+		return
+	}
+
+	now := c.conf.Now()
+
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			out := make([]map[string]interface{}, 0)
+			ids := make([]string, 0, len(c.objects))
+			for oid := range c.objects {
+				ids = append(ids, oid)
+			}
+			sort.Strings(ids)
+			for _, oid := range ids {
+				if o, ok := c.visible(oid, now); ok {
+					out = append(out, o.data)
+				}
+			}
+			writeFakeJSON(w, http.StatusOK, "", out)
+
+		case http.MethodPost:
+			data, ok := readFakeBody(w, r)
+			if !ok {
+				return
+			}
+			o := c.put(c.conf.IDFunc(), data, now.Add(c.conf.ConsistencyDelay))
+			writeFakeJSON(w, http.StatusCreated, o.etag(), o.data)
+
+		default:
+			writeFakeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", r.Method))
+		}
+		return
+	}
+
+	o, ok := c.visible(id, now)
+	if !ok || (!o.deletedAt.IsZero() && r.Method != http.MethodGet) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s %q not found", c.name, id))
+		return
+	}
+
+	if match := r.Header.Get("If-Match"); match != "" && match != "*" && match != o.etag() {
+		writeFakeError(w, http.StatusPreconditionFailed, fmt.Sprintf("etag %s does not match %s", match, o.etag()))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, o.etag(), o.data)
+
+	case http.MethodPut, http.MethodPatch:
+		data, ok := readFakeBody(w, r)
+		if !ok {
+			return
+		}
+		if r.Method == http.MethodPatch {
+			data = MergeMaps(o.data, data)
+		}
+		o = c.put(id, data, o.visibleAt)
+		writeFakeJSON(w, http.StatusOK, o.etag(), o.data)
+
+	case http.MethodDelete:
+		o.deletedAt = now
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", r.Method))
+	}
+}
+
+// FakeServer is a programmable stand-in for a remote API, serving in-memory CRUD collections of JSON objects
+type FakeServer struct {
+	*httptest.Server
+
+	mu          sync.RWMutex
+	collections map[string]*FakeCollection
+}
+
+// NewFakeServer starts a new FakeServer.  The caller must call Close when finished with it.
+func NewFakeServer() *FakeServer {
+	s := &FakeServer{
+		collections: make(map[string]*FakeCollection),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewTestFakeServer starts a new FakeServer that is closed when the test completes
+func NewTestFakeServer(t testing.TB) *FakeServer {
+	s := NewFakeServer()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// AddCollection registers a new collection, served at /{name} and /{name}/{id}.  Any existing collection with the same
+// name is replaced.
+func (s *FakeServer) AddCollection(name string, conf FakeCollectionConfig) *FakeCollection {
+	name = strings.Trim(name, "/")
+	c := newFakeCollection(name, conf)
+	s.mu.Lock()
+	s.collections[name] = c
+	s.mu.Unlock()
+	return c
+}
+
+// Collection returns the named collection, if registered
+func (s *FakeServer) Collection(name string) (*FakeCollection, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.collections[strings.Trim(name, "/")]
+	return c, ok
+}
+
+// CompileProviderConfig calls CompileProviderConfig with the server's URL set as the value of urlField
+func (s *FakeServer) CompileProviderConfig(providerName, urlField string, fieldMaps ...map[string]interface{}) string {
+	return CompileProviderConfig(providerName, append([]map[string]interface{}{{urlField: s.URL}}, fieldMaps...)...)
+}
+
+func (s *FakeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	name, id, _ := strings.Cut(strings.Trim(r.URL.Path, "/"), "/")
+	c, ok := s.Collection(name)
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("collection %q not found", name))
+		return
+	}
+	c.serve(w, r, id)
+}
+
+func copyFakeData(in map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func readFakeBody(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	data := make(map[string]interface{})
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("error decoding body: %v", err))
+		return nil, false
+	}
+	return data, true
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, etag string, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if etag != "" {
+		w.Header().Set("ETag", etag)
+	}
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, msg string) {
+	writeFakeJSON(w, status, "", map[string]interface{}{"error": msg})
+}
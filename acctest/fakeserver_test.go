@@ -0,0 +1,78 @@
+package acctest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestFakeServer(t *testing.T) {
+	srv := acctest.NewTestFakeServer(t)
+	now := time.Now()
+	things := srv.AddCollection("things", acctest.FakeCollectionConfig{
+		ConsistencyDelay: 50 * time.Millisecond,
+		Now:              func() time.Time { return now },
+	})
+
+	do := func(method, path, etag, body string) (*http.Response, map[string]interface{}) {
+		req, _ := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if etag != "" {
+			req.Header.Set("If-Match", etag)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Error executing %s %s: %v", method, path, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		out := make(map[string]interface{})
+		_ = json.NewDecoder(resp.Body).Decode(&out)
+		return resp, out
+	}
+
+	resp, created := do(http.MethodPost, "/things", "", `{"name":"fish"}`)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "1", created["id"])
+
+	// not yet consistent
+	resp, _ = do(http.MethodGet, "/things/1", "", "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	now = now.Add(60 * time.Millisecond)
+
+	resp, _ = do(http.MethodGet, "/things/1", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+
+	resp, patched := do(http.MethodPatch, "/things/1", etag, `{"size":2}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, map[string]interface{}{"id": "1", "name": "fish", "size": float64(2)}, patched)
+
+	resp, _ = do(http.MethodPut, "/things/1", etag, `{"name":"stale"}`)
+	assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+	// simulate drift
+	things.Put("1", map[string]interface{}{"name": "drifted"})
+	_, read := do(http.MethodGet, "/things/1", "", "")
+	assert.Equal(t, "drifted", read["name"])
+
+	resp, _ = do(http.MethodDelete, "/things/1", "", "")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, _ = do(http.MethodGet, "/things/1", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Expected deleted object to be readable until consistent")
+
+	now = now.Add(60 * time.Millisecond)
+	resp, _ = do(http.MethodGet, "/things/1", "", "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	failing := srv.AddCollection("failing", acctest.FakeCollectionConfig{ErrorRate: 1, ErrorStatus: http.StatusTooManyRequests})
+	resp, _ = do(http.MethodGet, "/"+failing.Name(), "", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	assert.Contains(t, srv.CompileProviderConfig("thing", "address"), srv.URL)
+}
@@ -0,0 +1,148 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+// ProviderFunctionCall returns a literal calling the named provider-defined function, with each argument rendered by
+// ConfigValue, e.g. provider::thing::parse_id("a/b")
+func ProviderFunctionCall(providerName, functionName string, args ...interface{}) ConfigLiteral {
+	rendered := make([]string, len(args))
+	for i, arg := range args {
+		rendered[i] = ConfigValue(arg)
+	}
+	return ConfigLiteral(fmt.Sprintf("provider::%s::%s(%s)", providerName, functionName, strings.Join(rendered, ", ")))
+}
+
+// CompileFunctionOutputConfig renders an output block whose value is the provided function call
+func CompileFunctionOutputConfig(outputName string, call ConfigLiteral) string {
+	return CompileOutputConfig(outputName, map[string]interface{}{"value": call})
+}
+
+// CompileFunctionAssertConfig renders an output block whose value is the provided function call, along with a
+// precondition failing the plan if the call's result does not equal expected.  Both sides are compared by their
+// jsonencode result, so a list may be expected as a tuple and a map as an object.
+func CompileFunctionAssertConfig(outputName string, call ConfigLiteral, expected interface{}) string {
+	return CompileOutputConfig(outputName, map[string]interface{}{
+		"value": call,
+		"precondition": StaticBlocks([]map[string]interface{}{
+			{
+				"condition":     ConfigLiteral(fmt.Sprintf("jsonencode(%s) == jsonencode(%s)", call, ConfigValue(expected))),
+				"error_message": fmt.Sprintf("Output %q does not match the expected value", outputName),
+			},
+		}),
+	})
+}
+
+// RunFunction calls the function's Run method in-process, without Terraform.  Each argument is converted to the type
+// of its corresponding parameter, and may be an attr.Value or any Go value with a natural mapping to that type, e.g. a
+// []string for a list of strings.  Arguments beyond the defined parameters are collected into a tuple for the variadic
+// parameter, as the framework does.
+//
+// Null and unknown arguments are rejected unless the parameter allows them, and arguments of custom types implementing
+// function.ValidateableParameter are validated, but the Validators defined on the parameter itself are not run.
+func RunFunction(ctx context.Context, fn function.Function, args ...interface{}) (attr.Value, error) {
+	defResp := function.DefinitionResponse{}
+	fn.Definition(ctx, function.DefinitionRequest{}, &defResp)
+	if defResp.Diagnostics.HasError() {
+		return nil, fmt.Errorf("%w: error reading definition: %v", ErrFunctionRunFailed, defResp.Diagnostics.Errors())
+	}
+	def := defResp.Definition
+
+	if len(args) < len(def.Parameters) || (def.VariadicParameter == nil && len(args) > len(def.Parameters)) {
+		return nil, fmt.Errorf("%w: expected %d arguments, saw %d", ErrFunctionArgumentInvalid, len(def.Parameters), len(args))
+	}
+
+	values := make([]attr.Value, 0, len(def.Parameters)+1)
+	variadicTypes := make([]attr.Type, 0)
+	variadicValues := make([]attr.Value, 0)
+
+	for i, arg := range args {
+		param := def.VariadicParameter
+		if i < len(def.Parameters) {
+			param = def.Parameters[i]
+		}
+
+		av, err := functionArgumentValue(ctx, i, param, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if i < len(def.Parameters) {
+			values = append(values, av)
+		} else {
+			variadicTypes = append(variadicTypes, param.GetType())
+			variadicValues = append(variadicValues, av)
+		}
+	}
+
+	if def.VariadicParameter != nil {
+		tv, diags := basetypes.NewTupleValue(variadicTypes, variadicValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%w: variadic arguments: %v", ErrFunctionArgumentInvalid, diags.Errors())
+		}
+		values = append(values, tv)
+	}
+
+	result, ferr := def.Return.NewResultData(ctx)
+	if ferr != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFunctionRunFailed, ferr)
+	}
+
+	resp := function.RunResponse{Result: result}
+	fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(values)}, &resp)
+	if resp.Error != nil {
+		return resp.Result.Value(), fmt.Errorf("%w: %v", ErrFunctionRunFailed, resp.Error)
+	}
+
+	return resp.Result.Value(), nil
+}
+
+// RunTestFunction calls RunFunction, failing the test if an error is returned
+func RunTestFunction(t testing.TB, fn function.Function, args ...interface{}) attr.Value {
+	t.Helper()
+	v, err := RunFunction(context.Background(), fn, args...)
+	if err != nil {
+		t.Fatalf("Error running function: %v", err)
+	}
+	return v
+}
+
+func functionArgumentValue(ctx context.Context, position int, param function.Parameter, arg interface{}) (attr.Value, error) {
+	typ := param.GetType()
+
+	tv, err := util.GoToTerraformValue(ctx, typ.TerraformType(ctx), arg)
+	if err != nil {
+		return nil, fmt.Errorf("%w: argument %d: %v", ErrFunctionArgumentInvalid, position, err)
+	}
+	av, err := typ.ValueFromTerraform(ctx, tv)
+	if err != nil {
+		return nil, fmt.Errorf("%w: argument %d: %v", ErrFunctionArgumentInvalid, position, err)
+	}
+
+	if av.IsNull() && !param.GetAllowNullValue() {
+		return nil, fmt.Errorf("%w: argument %d: null values are not allowed", ErrFunctionArgumentInvalid, position)
+	}
+	if av.IsUnknown() && !param.GetAllowUnknownValues() {
+		return nil, fmt.Errorf("%w: argument %d: unknown values are not allowed", ErrFunctionArgumentInvalid, position)
+	}
+
+	if vp, ok := av.(function.ValidateableParameter); ok {
+		resp := function.ValidateParameterResponse{}
+		vp.ValidateParameter(ctx, function.ValidateParameterRequest{Position: int64(position)}, &resp)
+		if resp.Error != nil {
+			return nil, fmt.Errorf("%w: argument %d: %v", ErrFunctionArgumentInvalid, position, resp.Error)
+		}
+	}
+
+	return av, nil
+}
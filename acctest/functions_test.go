@@ -0,0 +1,78 @@
+package acctest_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+type joinFunction struct{}
+
+func (joinFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "join"
+}
+
+func (joinFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "sep"},
+			function.ListParameter{Name: "first", ElementType: types.StringType},
+		},
+		VariadicParameter: function.StringParameter{Name: "rest"},
+		Return:            function.StringReturn{},
+	}
+}
+
+func (joinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		sep   string
+		first []string
+		rest  []string
+	)
+	resp.Error = req.Arguments.Get(ctx, &sep, &first, &rest)
+	if resp.Error != nil {
+		return
+	}
+	if sep == "" {
+		resp.Error = function.NewArgumentFuncError(0, "sep must not be empty")
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, strings.Join(append(first, rest...), sep))
+}
+
+func TestRunFunction(t *testing.T) {
+	v := acctest.RunTestFunction(t, joinFunction{}, "-", []string{"a", "b"}, "c", "d")
+	assert.Equal(t, types.StringValue("a-b-c-d"), v)
+
+	v = acctest.RunTestFunction(t, joinFunction{}, ",", types.ListValueMust(types.StringType, nil))
+	assert.Equal(t, types.StringValue(""), v)
+
+	_, err := acctest.RunFunction(context.Background(), joinFunction{}, "-")
+	assert.True(t, acctest.IsFunctionArgumentInvalidError(err), "Expected argument error, saw %v", err)
+
+	_, err = acctest.RunFunction(context.Background(), joinFunction{}, nil, []string{"a"})
+	assert.True(t, acctest.IsFunctionArgumentInvalidError(err), "Expected argument error, saw %v", err)
+
+	_, err = acctest.RunFunction(context.Background(), joinFunction{}, "-", []int{1})
+	assert.True(t, acctest.IsFunctionArgumentInvalidError(err), "Expected argument error, saw %v", err)
+
+	_, err = acctest.RunFunction(context.Background(), joinFunction{}, "", []string{"a"})
+	assert.True(t, acctest.IsFunctionRunFailedError(err), "Expected run error, saw %v", err)
+}
+
+func TestProviderFunctionCall(t *testing.T) {
+	call := acctest.ProviderFunctionCall("thing", "join", "-", []string{"a", "b"}, "c")
+	assert.Equal(t, acctest.ConfigLiteral("provider::thing::join(\"-\", [\n\"a\",\n\"b\"\n], \"c\")"), call)
+
+	conf := acctest.MustJoinConfigs(t,
+		acctest.CompileFunctionOutputConfig("joined", call),
+		acctest.CompileFunctionAssertConfig("joined_assert", call, "a-b-c"),
+	)
+	acctest.AssertConfigSnapshot(t, "", conf)
+}
@@ -0,0 +1,388 @@
+package acctest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// LintConfigFilename is the filename used in the ranges of issues reported by LintConfig
+const LintConfigFilename = "config.tf"
+
+type LintIssueKind string
+
+const (
+	// LintDuplicateAddress is reported when two blocks declare the same address, e.g. two `resource "x" "test"` blocks
+	LintDuplicateAddress LintIssueKind = "duplicate_address"
+	// LintDanglingReference is reported when an expression references a resource, data source, variable, local,
+	// module, or aliased provider configuration that is not declared anywhere in the config
+	LintDanglingReference LintIssueKind = "dangling_reference"
+	// LintUnusedLocal is reported when a local value is declared but never referenced
+	LintUnusedLocal LintIssueKind = "unused_local"
+	// LintDuplicateProvider is reported when two provider blocks share the same name and alias
+	LintDuplicateProvider LintIssueKind = "duplicate_provider"
+)
+
+// LintIssue describes a single problem found by LintConfig
+type LintIssue struct {
+	Kind    LintIssueKind
+	Address string
+	Range   hcl.Range
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Range.String(), i.Kind, i.Address)
+}
+
+type LintIssues []LintIssue
+
+func (li LintIssues) String() string {
+	bits := make([]string, len(li))
+	for i, issue := range li {
+		bits[i] = issue.String()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(bits, "; "))
+}
+
+// Kinds returns the kind of each issue, in order
+func (li LintIssues) Kinds() []LintIssueKind {
+	out := make([]LintIssueKind, len(li))
+	for i, issue := range li {
+		out[i] = issue.Kind
+	}
+	return out
+}
+
+// reference roots that never point at a declared block
+var lintIgnoredRoots = map[string]struct{}{
+	"count":     {},
+	"each":      {},
+	"self":      {},
+	"path":      {},
+	"terraform": {},
+}
+
+type lintDecl struct {
+	address string
+	rng     hcl.Range
+}
+
+type lintRef struct {
+	address  string
+	local    string
+	provider bool
+	rng      hcl.Range
+}
+
+type linter struct {
+	declared  map[string]struct{}
+	providers map[string]struct{}
+	locals    []lintDecl
+	refs      []lintRef
+	issues    LintIssues
+}
+
+// LintConfig parses the provided config and reports duplicate block addresses, dangling references, unused locals and
+// duplicated provider configurations.  Any issue kinds provided as ignore will not be reported.
+//
+// The returned error is only non-nil when the config could not be parsed.
+func LintConfig(conf string, ignore ...LintIssueKind) (LintIssues, error) {
+	f, diags := hclsyntax.ParseConfig([]byte(conf), LintConfigFilename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, ConfigParseFailedError(diags)
+	}
+
+	l := &linter{
+		declared:  make(map[string]struct{}),
+		providers: make(map[string]struct{}),
+	}
+
+	body := f.Body.(*hclsyntax.Body)
+	for _, block := range body.Blocks {
+		l.declare(block)
+	}
+	for _, block := range body.Blocks {
+		l.collectBlockRefs(block)
+	}
+	l.check()
+
+	out := make(LintIssues, 0)
+	for _, issue := range l.issues {
+		skip := false
+		for _, k := range ignore {
+			if issue.Kind == k {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			out = append(out, issue)
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Range.Start.Byte < out[j].Range.Start.Byte
+	})
+
+	return out, nil
+}
+
+// JoinConfigsChecked joins the provided configs together as JoinConfigs does, returning an error if the resulting
+// config cannot be parsed or LintConfig reports any issues.
+func JoinConfigsChecked(confs ...string) (string, error) {
+	joined := JoinConfigs(confs...)
+	issues, err := LintConfig(joined)
+	if err != nil {
+		return joined, err
+	}
+	if len(issues) > 0 {
+		return joined, ConfigLintFailedError(issues)
+	}
+	return joined, nil
+}
+
+// MustJoinConfigs calls JoinConfigsChecked, failing the test immediately if an error is returned.
+func MustJoinConfigs(t testing.TB, confs ...string) string {
+	t.Helper()
+	joined, err := JoinConfigsChecked(confs...)
+	if err != nil {
+		t.Log(RedactConfig(joined))
+		t.Fatalf("Joined config failed lint: %v", err)
+	}
+	return joined
+}
+
+func (l *linter) addIssue(kind LintIssueKind, address string, rng hcl.Range) {
+	l.issues = append(l.issues, LintIssue{Kind: kind, Address: address, Range: rng})
+}
+
+func (l *linter) addDecl(address string, rng hcl.Range) {
+	if _, ok := l.declared[address]; ok {
+		l.addIssue(LintDuplicateAddress, address, rng)
+		return
+	}
+	l.declared[address] = struct{}{}
+}
+
+func (l *linter) declare(block *hclsyntax.Block) {
+	rng := block.DefRange()
+	switch block.Type {
+	case "resource":
+		if len(block.Labels) == 2 {
+			l.addDecl(fmt.Sprintf("%s.%s", block.Labels[0], block.Labels[1]), rng)
+		}
+	case "data", "ephemeral":
+		if len(block.Labels) == 2 {
+			l.addDecl(fmt.Sprintf("%s.%s.%s", block.Type, block.Labels[0], block.Labels[1]), rng)
+		}
+	case "module":
+		if len(block.Labels) == 1 {
+			l.addDecl(fmt.Sprintf("module.%s", block.Labels[0]), rng)
+		}
+	case "variable":
+		if len(block.Labels) == 1 {
+			l.addDecl(fmt.Sprintf("var.%s", block.Labels[0]), rng)
+		}
+	case "output":
+		if len(block.Labels) == 1 {
+			l.addDecl(fmt.Sprintf("output.%s", block.Labels[0]), rng)
+		}
+	case "locals":
+		for _, attr := range sortedAttributes(block.Body) {
+			address := fmt.Sprintf("local.%s", attr.Name)
+			if _, ok := l.declared[address]; ok {
+				l.addIssue(LintDuplicateAddress, address, attr.NameRange)
+				continue
+			}
+			l.declared[address] = struct{}{}
+			l.locals = append(l.locals, lintDecl{address: address, rng: attr.NameRange})
+		}
+	case "provider":
+		if len(block.Labels) == 1 {
+			address := fmt.Sprintf("provider.%s", block.Labels[0])
+			if attr, ok := block.Body.Attributes["alias"]; ok {
+				if v, diags := attr.Expr.Value(nil); !diags.HasErrors() && v.Type() == cty.String && v.IsKnown() && !v.IsNull() {
+					address = fmt.Sprintf("%s.%s", address, v.AsString())
+				}
+			}
+			if _, ok := l.providers[address]; ok {
+				l.addIssue(LintDuplicateProvider, address, rng)
+			} else {
+				l.providers[address] = struct{}{}
+			}
+		}
+	}
+}
+
+func (l *linter) collectBlockRefs(block *hclsyntax.Block) {
+	var skip []string
+	switch block.Type {
+	case "terraform":
+		return
+	case "resource", "data", "ephemeral":
+		skip = []string{"provider"}
+		l.collectProviderRef(block)
+	case "module":
+		skip = []string{"providers"}
+	case "variable":
+		skip = []string{"type"}
+	case "moved", "import", "removed":
+		skip = []string{"from", "to"}
+	}
+	l.collectBodyRefs(block.Body, nil, skip...)
+}
+
+// collectProviderRef records a reference to an aliased provider configuration.  References to a default provider
+// configuration are not recorded, as Terraform will create them implicitly.
+func (l *linter) collectProviderRef(block *hclsyntax.Block) {
+	attr, ok := block.Body.Attributes["provider"]
+	if !ok {
+		return
+	}
+	trav, diags := hcl.AbsTraversalForExpr(attr.Expr)
+	if diags.HasErrors() {
+		return
+	}
+	if names := traversalAttrNames(trav); len(names) == 1 {
+		l.refs = append(l.refs, lintRef{
+			address:  fmt.Sprintf("provider.%s.%s", trav.RootName(), names[0]),
+			provider: true,
+			rng:      trav.SourceRange(),
+		})
+	}
+}
+
+func (l *linter) collectBodyRefs(body *hclsyntax.Body, scope map[string]struct{}, skip ...string) {
+outer:
+	for _, attr := range sortedAttributes(body) {
+		for _, s := range skip {
+			if attr.Name == s {
+				continue outer
+			}
+		}
+		l.collectExprRefs(attr.Expr, scope)
+	}
+
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "lifecycle":
+			l.collectBodyRefs(block.Body, scope, "ignore_changes")
+
+		case "dynamic":
+			if len(block.Labels) != 1 {
+				continue
+			}
+			iterator := block.Labels[0]
+			if attr, ok := block.Body.Attributes["iterator"]; ok {
+				if name := hcl.ExprAsKeyword(attr.Expr); name != "" {
+					iterator = name
+				}
+			}
+			for _, attr := range sortedAttributes(block.Body) {
+				if attr.Name != "iterator" {
+					l.collectExprRefs(attr.Expr, scope)
+				}
+			}
+			inner := make(map[string]struct{}, len(scope)+1)
+			for k := range scope {
+				inner[k] = struct{}{}
+			}
+			inner[iterator] = struct{}{}
+			for _, content := range block.Body.Blocks {
+				l.collectBodyRefs(content.Body, inner)
+			}
+
+		default:
+			l.collectBodyRefs(block.Body, scope)
+		}
+	}
+}
+
+func (l *linter) collectExprRefs(expr hclsyntax.Expression, scope map[string]struct{}) {
+	for _, trav := range expr.Variables() {
+		root := trav.RootName()
+		if _, ok := lintIgnoredRoots[root]; ok {
+			continue
+		}
+		if _, ok := scope[root]; ok {
+			continue
+		}
+
+		names := traversalAttrNames(trav)
+		rng := trav.SourceRange()
+
+		switch root {
+		case "var", "local", "module":
+			if len(names) < 1 {
+				continue
+			}
+			ref := lintRef{address: fmt.Sprintf("%s.%s", root, names[0]), rng: rng}
+			if root == "local" {
+				ref.local = ref.address
+			}
+			l.refs = append(l.refs, ref)
+
+		case "data", "ephemeral":
+			if len(names) < 2 {
+				continue
+			}
+			l.refs = append(l.refs, lintRef{address: fmt.Sprintf("%s.%s.%s", root, names[0], names[1]), rng: rng})
+
+		default:
+			if len(names) < 1 {
+				continue
+			}
+			l.refs = append(l.refs, lintRef{address: fmt.Sprintf("%s.%s", root, names[0]), rng: rng})
+		}
+	}
+}
+
+func (l *linter) check() {
+	used := make(map[string]struct{})
+	for _, ref := range l.refs {
+		if ref.local != "" {
+			used[ref.local] = struct{}{}
+		}
+		if ref.provider {
+			if _, ok := l.providers[ref.address]; !ok {
+				l.addIssue(LintDanglingReference, ref.address, ref.rng)
+			}
+		} else if _, ok := l.declared[ref.address]; !ok {
+			l.addIssue(LintDanglingReference, ref.address, ref.rng)
+		}
+	}
+	for _, local := range l.locals {
+		if _, ok := used[local.address]; !ok {
+			l.addIssue(LintUnusedLocal, local.address, local.rng)
+		}
+	}
+}
+
+// traversalAttrNames returns the leading attribute names following the root of the traversal
+func traversalAttrNames(trav hcl.Traversal) []string {
+	out := make([]string, 0)
+	for _, step := range trav[1:] {
+		ta, ok := step.(hcl.TraverseAttr)
+		if !ok {
+			break
+		}
+		out = append(out, ta.Name)
+	}
+	return out
+}
+
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	out := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		out = append(out, attr)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].SrcRange.Start.Byte < out[j].SrcRange.Start.Byte
+	})
+	return out
+}
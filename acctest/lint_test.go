@@ -0,0 +1,141 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestLintConfig(t *testing.T) {
+	type lintTest struct {
+		name   string
+		conf   string
+		ignore []acctest.LintIssueKind
+		kinds  []acctest.LintIssueKind
+	}
+
+	theTests := []lintTest{
+		{
+			name: "clean",
+			conf: acctest.JoinConfigs(
+				acctest.CompileProviderConfig("thing", map[string]interface{}{"address": acctest.ConfigLiteral("var.address")}),
+				acctest.CompileProviderAliasConfig("thing", "west"),
+				`variable "address" {
+  type = string
+}`,
+				acctest.CompileLocalsConfig(map[string]interface{}{"name": "fish"}),
+				acctest.CompileResourceConfig("thing_fish", "test", map[string]interface{}{
+					"name":     acctest.ConfigLiteral("local.name"),
+					"provider": acctest.ConfigLiteral("thing.west"),
+				}),
+				acctest.CompileDataSourceConfig("thing_fish", "test", map[string]interface{}{
+					"id": acctest.ConfigLiteral("thing_fish.test.id"),
+				}),
+			),
+			kinds: []acctest.LintIssueKind{},
+		},
+		{
+			name: "dangling-provider-alias",
+			conf: acctest.JoinConfigs(
+				acctest.CompileProviderConfig("thing", nil),
+				acctest.CompileResourceConfigWithProvider("thing_fish", "test", "thing", "east", nil),
+			),
+			kinds: []acctest.LintIssueKind{acctest.LintDanglingReference},
+		},
+		{
+			name: "duplicate-address",
+			conf: acctest.JoinConfigs(
+				acctest.CompileResourceConfig("thing_fish", "test", nil),
+				acctest.CompileResourceConfig("thing_fish", "test", nil),
+			),
+			kinds: []acctest.LintIssueKind{acctest.LintDuplicateAddress},
+		},
+		{
+			name: "dangling-references",
+			conf: acctest.CompileResourceConfig("thing_fish", "test", map[string]interface{}{
+				"a": acctest.ConfigLiteral("thing_bowl.test.id"),
+				"b": acctest.ConfigLiteral("data.thing_bowl.test.id"),
+				"c": acctest.ConfigLiteral("var.nope"),
+				"d": acctest.ConfigLiteral("local.nope"),
+				"e": acctest.ConfigLiteral("module.nope.out"),
+			}),
+			kinds: []acctest.LintIssueKind{
+				acctest.LintDanglingReference,
+				acctest.LintDanglingReference,
+				acctest.LintDanglingReference,
+				acctest.LintDanglingReference,
+				acctest.LintDanglingReference,
+			},
+		},
+		{
+			name:  "unused-local",
+			conf:  acctest.CompileLocalsConfig(map[string]interface{}{"name": "fish"}),
+			kinds: []acctest.LintIssueKind{acctest.LintUnusedLocal},
+		},
+		{
+			name:   "unused-local-ignored",
+			conf:   acctest.CompileLocalsConfig(map[string]interface{}{"name": "fish"}),
+			ignore: []acctest.LintIssueKind{acctest.LintUnusedLocal},
+			kinds:  []acctest.LintIssueKind{},
+		},
+		{
+			name: "duplicate-provider",
+			conf: acctest.JoinConfigs(
+				acctest.CompileProviderConfig("thing", nil),
+				acctest.CompileProviderConfig("thing", nil),
+				acctest.CompileProviderConfig("thing", map[string]interface{}{"alias": "west"}),
+			),
+			kinds: []acctest.LintIssueKind{acctest.LintDuplicateProvider},
+		},
+		{
+			name: "scoped-references",
+			conf: `resource "thing_fish" "test" {
+  count = 2
+  name  = "fish-${count.index}"
+
+  dynamic "scale" {
+    for_each = [1, 2]
+    iterator = s
+    content {
+      size = s.value
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}`,
+			kinds: []acctest.LintIssueKind{},
+		},
+	}
+
+	for _, theT := range theTests {
+		t.Run(theT.name, func(t *testing.T) {
+			issues, err := acctest.LintConfig(theT.conf, theT.ignore...)
+			if err != nil {
+				t.Log(theT.conf)
+				t.Fatalf("Unexpected error linting config: %v", err)
+			}
+			assert.Equal(t, theT.kinds, issues.Kinds(), "Unexpected lint issues: %v", issues)
+		})
+	}
+}
+
+func TestJoinConfigsChecked(t *testing.T) {
+	_, err := acctest.JoinConfigsChecked(
+		acctest.CompileResourceConfig("thing_fish", "test", nil),
+		acctest.CompileResourceConfig("thing_fish", "test", nil),
+	)
+	if !acctest.IsConfigLintFailedError(err) {
+		t.Logf("Expected lint failed error, saw %v", err)
+		t.Fail()
+	}
+
+	_, err = acctest.JoinConfigsChecked(`resource "thing_fish" {`)
+	if !acctest.IsConfigParseFailedError(err) {
+		t.Logf("Expected parse failed error, saw %v", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,186 @@
+package acctest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// MatrixVariant is a single named set of fields within a MatrixDimension
+type MatrixVariant struct {
+	Name   string
+	Fields map[string]interface{}
+}
+
+// MatrixDimension is a named axis of a Matrix, e.g. "auth" with variants "token" and "basic"
+type MatrixDimension struct {
+	Name     string
+	Variants []MatrixVariant
+}
+
+// MatrixFilter is used to include or exclude cases produced by a Matrix
+type MatrixFilter func(MatrixCase) bool
+
+// Matrix produces the cartesian product of its dimensions' variants.  Each case's fields are the Root map merged with
+// the fields of each selected variant, in dimension order.
+type Matrix struct {
+	Root       map[string]interface{}
+	Dimensions []MatrixDimension
+
+	// Include, if defined, limits cases to those matching at least one filter
+	Include []MatrixFilter
+	// Exclude removes any case matching at least one filter
+	Exclude []MatrixFilter
+}
+
+// MatrixCase is a single combination of variants produced by a Matrix
+type MatrixCase struct {
+	// Name is a stable name built from the dimension and variant names, suitable for use with t.Run
+	Name string
+	// Variants maps each dimension name to the name of the variant selected for this case
+	Variants map[string]string
+	// Fields is the merged field map for this case
+	Fields map[string]interface{}
+}
+
+// Is returns true if this case selected the named variant for the named dimension
+func (c MatrixCase) Is(dimension, variant string) bool {
+	v, ok := c.Variants[dimension]
+	return ok && v == variant
+}
+
+// CompileConfig calls CompileConfig with this case's fields merged with any extra field maps provided
+func (c MatrixCase) CompileConfig(header string, fieldMaps ...map[string]interface{}) string {
+	return CompileConfig(header, append([]map[string]interface{}{c.Fields}, fieldMaps...)...)
+}
+
+// CompileProviderConfig calls CompileProviderConfig with this case's fields merged with any extra field maps provided
+func (c MatrixCase) CompileProviderConfig(providerName string, fieldMaps ...map[string]interface{}) string {
+	return c.CompileConfig(ProviderHeader(providerName), fieldMaps...)
+}
+
+// CompileResourceConfig calls CompileResourceConfig with this case's fields merged with any extra field maps provided
+func (c MatrixCase) CompileResourceConfig(resourceType, resourceName string, fieldMaps ...map[string]interface{}) string {
+	return c.CompileConfig(ResourceHeader(resourceType, resourceName), fieldMaps...)
+}
+
+// CompileDataSourceConfig calls CompileDataSourceConfig with this case's fields merged with any extra field maps
+// provided
+func (c MatrixCase) CompileDataSourceConfig(dataSourceType, dataSourceName string, fieldMaps ...map[string]interface{}) string {
+	return c.CompileConfig(DataSourceHeader(dataSourceType, dataSourceName), fieldMaps...)
+}
+
+// MatrixSelect returns a filter matching cases that selected every provided dimension -> variant pair
+func MatrixSelect(variants map[string]string) MatrixFilter {
+	return func(c MatrixCase) bool {
+		for d, v := range variants {
+			if !c.Is(d, v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Validate returns an error if the matrix cannot produce cases, e.g. because a dimension has no variants
+func (m Matrix) Validate() error {
+	for i, dim := range m.Dimensions {
+		if len(dim.Variants) == 0 {
+			return fmt.Errorf("matrix dimension %d (%q) has no variants", i, dim.Name)
+		}
+	}
+	return nil
+}
+
+// Cases returns every case produced by the matrix after filtering.  Cases are ordered by dimension, then by variant,
+// in the order they were defined.  An error is returned if the matrix fails Validate.
+func (m Matrix) Cases() ([]MatrixCase, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	out := make([]MatrixCase, 0)
+	if len(m.Dimensions) == 0 {
+		return out, nil
+	}
+
+	idx := make([]int, len(m.Dimensions))
+	for {
+		c := m.buildCase(idx)
+		if m.keep(c) {
+			out = append(out, c)
+		}
+
+		// advance the last dimension first, carrying over into earlier dimensions
+		i := len(idx) - 1
+		for ; i >= 0; i-- {
+			idx[i]++
+			if idx[i] < len(m.Dimensions[i].Variants) {
+				break
+			}
+			idx[i] = 0
+		}
+		if i < 0 {
+			return out, nil
+		}
+	}
+}
+
+// Run executes fn as a subtest for each case produced by the matrix, failing the test immediately if the matrix is
+// invalid
+func (m Matrix) Run(t *testing.T, fn func(t *testing.T, c MatrixCase)) {
+	t.Helper()
+	cases, err := m.Cases()
+	if err != nil {
+		t.Fatalf("Error building matrix cases: %v", err)
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			fn(t, c)
+		})
+	}
+}
+
+func (m Matrix) buildCase(idx []int) MatrixCase {
+	var (
+		names  = make([]string, 0, len(idx))
+		fields = []map[string]interface{}{m.Root}
+
+		c = MatrixCase{
+			Variants: make(map[string]string, len(idx)),
+		}
+	)
+
+	for i, vi := range idx {
+		dim := m.Dimensions[i]
+		variant := dim.Variants[vi]
+		c.Variants[dim.Name] = variant.Name
+		names = append(names, fmt.Sprintf("%s=%s", dim.Name, variant.Name))
+		fields = append(fields, variant.Fields)
+	}
+
+	c.Name = strings.Join(names, ",")
+	c.Fields = MergeMaps(fields...)
+	return c
+}
+
+func (m Matrix) keep(c MatrixCase) bool {
+	if len(m.Include) > 0 {
+		included := false
+		for _, fn := range m.Include {
+			if fn(c) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, fn := range m.Exclude {
+		if fn(c) {
+			return false
+		}
+	}
+	return true
+}
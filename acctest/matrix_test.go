@@ -0,0 +1,68 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestMatrix_Cases(t *testing.T) {
+	m := acctest.Matrix{
+		Root: map[string]interface{}{"name": "test", "class": "default"},
+		Dimensions: []acctest.MatrixDimension{
+			{
+				Name: "auth",
+				Variants: []acctest.MatrixVariant{
+					{Name: "token", Fields: map[string]interface{}{"token": "abc"}},
+					{Name: "basic", Fields: map[string]interface{}{"username": "u", "password": "p"}},
+				},
+			},
+			{
+				Name: "storage",
+				Variants: []acctest.MatrixVariant{
+					{Name: "standard"},
+					{Name: "cold", Fields: map[string]interface{}{"class": "cold"}},
+					{Name: "archive", Fields: map[string]interface{}{"class": "archive"}},
+				},
+			},
+		},
+		Exclude: []acctest.MatrixFilter{
+			acctest.MatrixSelect(map[string]string{"auth": "basic", "storage": "archive"}),
+		},
+	}
+
+	cases, err := m.Cases()
+	if !assert.NoError(t, err) {
+		return
+	}
+	names := make([]string, len(cases))
+	for i, c := range cases {
+		names[i] = c.Name
+	}
+
+	assert.Equal(t, []string{
+		"auth=token,storage=standard",
+		"auth=token,storage=cold",
+		"auth=token,storage=archive",
+		"auth=basic,storage=standard",
+		"auth=basic,storage=cold",
+	}, names)
+
+	assert.Equal(t, map[string]interface{}{"name": "test", "class": "cold", "username": "u", "password": "p"}, cases[4].Fields)
+
+	m.Include = []acctest.MatrixFilter{
+		func(c acctest.MatrixCase) bool { return c.Is("storage", "standard") },
+	}
+	cases, err = m.Cases()
+	assert.NoError(t, err)
+	assert.Len(t, cases, 2)
+
+	m.Dimensions = append(m.Dimensions, acctest.MatrixDimension{Name: "empty"})
+	assert.Error(t, m.Validate())
+	assert.NotPanics(t, func() {
+		_, err = m.Cases()
+	})
+	assert.Error(t, err, "Expected error building cases with an empty dimension")
+}
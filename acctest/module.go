@@ -0,0 +1,106 @@
+package acctest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	ModuleMainFile      = "main.tf"
+	ModuleVariablesFile = "variables.tf"
+	ModuleOutputsFile   = "outputs.tf"
+)
+
+func VariableHeader(name string) string {
+	const f = `variable %q`
+	return fmt.Sprintf(f, name)
+}
+
+func OutputHeader(name string) string {
+	const f = `output %q`
+	return fmt.Sprintf(f, name)
+}
+
+func ModuleHeader(name string) string {
+	const f = `module %q`
+	return fmt.Sprintf(f, name)
+}
+
+func CompileVariableConfig(variableName string, fieldMaps ...map[string]interface{}) string {
+	return CompileConfig(
+		VariableHeader(variableName),
+		fieldMaps...,
+	)
+}
+
+func CompileOutputConfig(outputName string, fieldMaps ...map[string]interface{}) string {
+	return CompileConfig(
+		OutputHeader(outputName),
+		fieldMaps...,
+	)
+}
+
+// CompileModuleConfig renders a module block calling the module at source with the provided inputs
+func CompileModuleConfig(moduleName, source string, fieldMaps ...map[string]interface{}) string {
+	return CompileConfig(
+		ModuleHeader(moduleName),
+		append([]map[string]interface{}{{"source": source}}, fieldMaps...)...,
+	)
+}
+
+// ModuleFiles describes the contents of a module directory.  Each field is typically built with JoinConfigs and the
+// Compile*Config funcs.  Empty files are not written.
+type ModuleFiles struct {
+	Main      string
+	Variables string
+	Outputs   string
+
+	// Files contains any additional files to write, keyed by their path relative to the module directory
+	Files map[string]string
+}
+
+// Write writes the module's files into dir, creating it if necessary
+func (m ModuleFiles) Write(dir string) error {
+	files := map[string]string{
+		ModuleMainFile:      m.Main,
+		ModuleVariablesFile: m.Variables,
+		ModuleOutputsFile:   m.Outputs,
+	}
+	for name, content := range m.Files {
+		files[name] = content
+	}
+
+	for _, name := range SortedKeys(files) {
+		if files[name] == "" {
+			continue
+		}
+		fpath := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+			return fmt.Errorf("error creating module directory for %q: %w", fpath, err)
+		}
+		if err := os.WriteFile(fpath, []byte(files[name]), 0644); err != nil {
+			return fmt.Errorf("error writing module file %q: %w", fpath, err)
+		}
+	}
+	return nil
+}
+
+// WriteTestModule writes the module's files into a new temporary directory, removed when the test completes, and
+// returns its absolute path
+func WriteTestModule(t testing.TB, m ModuleFiles) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := m.Write(dir); err != nil {
+		t.Fatalf("Error writing test module: %v", err)
+	}
+	return dir
+}
+
+// CompileTestModuleConfig writes the module's files with WriteTestModule and renders a module block calling it.  The
+// source is the module directory's absolute path, which terraform init copies into the working directory.
+func CompileTestModuleConfig(t testing.TB, moduleName string, m ModuleFiles, fieldMaps ...map[string]interface{}) string {
+	t.Helper()
+	return CompileModuleConfig(moduleName, WriteTestModule(t, m), fieldMaps...)
+}
@@ -0,0 +1,55 @@
+package acctest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestCompileTestModuleConfig(t *testing.T) {
+	m := acctest.ModuleFiles{
+		Main: acctest.CompileResourceConfig("thing_fish", "test", map[string]interface{}{
+			"name": acctest.ConfigLiteral("var.name"),
+		}),
+		Variables: acctest.CompileVariableConfig("name", map[string]interface{}{
+			"type": acctest.ConfigLiteral("string"),
+		}),
+		Outputs: acctest.CompileOutputConfig("id", map[string]interface{}{
+			"value": acctest.ConfigLiteral("thing_fish.test.id"),
+		}),
+		Files: map[string]string{"README.md": "# test module"},
+	}
+
+	moduleConf := acctest.JoinConfigs(m.Main, m.Variables, m.Outputs)
+	issues, err := acctest.LintConfig(moduleConf)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+
+	dir := acctest.WriteTestModule(t, m)
+	for name, expected := range map[string]string{
+		acctest.ModuleMainFile:      m.Main,
+		acctest.ModuleVariablesFile: m.Variables,
+		acctest.ModuleOutputsFile:   m.Outputs,
+		"README.md":                 "# test module",
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, expected, string(b), name)
+		}
+	}
+
+	conf := acctest.JoinConfigs(
+		acctest.CompileTestModuleConfig(t, "fish", m, map[string]interface{}{"name": "fish"}),
+		acctest.CompileOutputConfig("fish_id", map[string]interface{}{
+			"value": acctest.ConfigLiteral("module.fish.id"),
+		}),
+	)
+	assert.Contains(t, conf, `module "fish"`)
+	issues, err = acctest.LintConfig(conf)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
@@ -0,0 +1,40 @@
+package acctest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// DefaultNamePrefix is the default value of NamePrefix
+const DefaultNamePrefix = "tf-acc-test"
+
+// NamePrefix is prepended to every name produced by RandomName.  Sweepers use it to identify resources created by
+// tests.
+var NamePrefix = DefaultNamePrefix
+
+const randomNameCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// RandomString returns a random string of length n, consisting of lowercase letters and digits
+func RandomString(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = randomNameCharset[rand.Intn(len(randomNameCharset))]
+	}
+	return string(b)
+}
+
+// RandomWithPrefix returns the provided prefix joined to a random string with "-"
+func RandomWithPrefix(prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, RandomString(10))
+}
+
+// RandomName returns a random name starting with NamePrefix
+func RandomName() string {
+	return RandomWithPrefix(NamePrefix)
+}
+
+// HasNamePrefix returns true if the provided name starts with NamePrefix
+func HasNamePrefix(name string) bool {
+	return strings.HasPrefix(name, NamePrefix)
+}
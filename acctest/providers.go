@@ -0,0 +1,48 @@
+package acctest
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ProviderAlias describes a single aliased provider configuration
+type ProviderAlias struct {
+	Alias  string
+	Fields map[string]interface{}
+}
+
+// ProviderReference returns a literal referencing the provided provider configuration, suitable for use as the
+// value of a resource's provider field.  If alias is empty, the default configuration is referenced.
+func ProviderReference(providerName, alias string) ConfigLiteral {
+	if alias == "" {
+		return ConfigLiteral(providerName)
+	}
+	return ConfigLiteral(fmt.Sprintf("%s.%s", providerName, alias))
+}
+
+// CompileProviderAliasConfig calls CompileProviderConfig with the alias field set
+func CompileProviderAliasConfig(providerName, alias string, fieldMaps ...map[string]interface{}) string {
+	return CompileProviderConfig(providerName, append(slices.Clone(fieldMaps), map[string]interface{}{"alias": alias})...)
+}
+
+// CompileProviderAliasConfigs renders one provider block per alias, each with the root field map merged with the
+// alias's own fields.  This may be used to produce multi-region or multi-account test layouts.
+func CompileProviderAliasConfigs(providerName string, root map[string]interface{}, aliases ...ProviderAlias) string {
+	confs := make([]string, len(aliases))
+	for i, pa := range aliases {
+		confs[i] = CompileProviderAliasConfig(providerName, pa.Alias, root, pa.Fields)
+	}
+	return JoinConfigs(confs...)
+}
+
+// CompileResourceConfigWithProvider calls CompileResourceConfig with the provider field set to reference the provided
+// provider configuration
+func CompileResourceConfigWithProvider(resourceType, resourceName, providerName, alias string, fieldMaps ...map[string]interface{}) string {
+	return CompileResourceConfig(resourceType, resourceName, append(slices.Clone(fieldMaps), map[string]interface{}{"provider": ProviderReference(providerName, alias)})...)
+}
+
+// CompileDataSourceConfigWithProvider calls CompileDataSourceConfig with the provider field set to reference the
+// provided provider configuration
+func CompileDataSourceConfigWithProvider(dataSourceType, dataSourceName, providerName, alias string, fieldMaps ...map[string]interface{}) string {
+	return CompileDataSourceConfig(dataSourceType, dataSourceName, append(slices.Clone(fieldMaps), map[string]interface{}{"provider": ProviderReference(providerName, alias)})...)
+}
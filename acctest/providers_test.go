@@ -0,0 +1,34 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestProviderAliases(t *testing.T) {
+	conf := acctest.MustJoinConfigs(t,
+		acctest.CompileProviderConfig("thing", map[string]interface{}{"region": "us-east-1"}),
+		acctest.CompileProviderAliasConfigs("thing",
+			map[string]interface{}{"token": "abc", "region": "us-east-1"},
+			acctest.ProviderAlias{Alias: "west", Fields: map[string]interface{}{"region": "us-west-2"}},
+			acctest.ProviderAlias{Alias: "other_account", Fields: map[string]interface{}{"token": "def"}},
+		),
+		acctest.CompileResourceConfigWithProvider("thing_fish", "west", "thing", "west", map[string]interface{}{"name": "fish"}),
+		acctest.CompileResourceConfigWithProvider("thing_fish", "other", "thing", "other_account", map[string]interface{}{"name": "fish"}),
+	)
+	acctest.AssertConfigSnapshot(t, "", conf)
+}
+
+func TestProviderFieldMapsUnmodified(t *testing.T) {
+	maps := []map[string]interface{}{{"name": "fish"}, {"size": 2}}
+	next := maps[1]
+
+	acctest.CompileProviderAliasConfig("thing", "west", maps[:1]...)
+	acctest.CompileResourceConfigWithProvider("thing_fish", "west", "thing", "west", maps[:1]...)
+	acctest.CompileDataSourceConfigWithProvider("thing_fish", "west", "thing", "west", maps[:1]...)
+
+	assert.Equal(t, next, maps[1], "Caller's field maps must not be overwritten")
+}
@@ -0,0 +1,493 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// RecorderModeEnv selects the RecorderMode used by NewTestRecorder when one is not explicitly configured.  It is
+	// intended to be set alongside TF_ACC, e.g. `TF_ACC=1 TF_ACC_RECORDER=replay go test ./...`
+	RecorderModeEnv = "TF_ACC_RECORDER"
+
+	// RedactedValue replaces any value removed by a Redactor
+	RedactedValue = "REDACTED"
+)
+
+// RecordingsDir is the directory, relative to the test's working directory, NewTestRecorder stores cassettes in
+var RecordingsDir = filepath.Join("testdata", "recordings")
+
+type RecorderMode string
+
+const (
+	// RecorderModeOff passes requests through to the underlying transport without recording them
+	RecorderModeOff RecorderMode = "off"
+	// RecorderModeRecord passes requests through to the underlying transport, recording each interaction
+	RecorderModeRecord RecorderMode = "record"
+	// RecorderModeReplay serves responses from a previously recorded cassette, never contacting the remote API
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// RecorderModeFromEnv returns the mode defined by RecorderModeEnv, defaulting to RecorderModeOff if it is not set.
+func RecorderModeFromEnv() (RecorderMode, error) {
+	switch v := RecorderMode(strings.ToLower(strings.TrimSpace(os.Getenv(RecorderModeEnv)))); v {
+	case "":
+		return RecorderModeOff, nil
+	case RecorderModeOff, RecorderModeRecord, RecorderModeReplay:
+		return v, nil
+
+	default:
+		return "", fmt.Errorf("%w: %q", ErrRecorderModeInvalid, v)
+	}
+}
+
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Cassette is the on-disk representation of a set of recorded interactions
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// RequestMatcher determines whether an outgoing request matches a recorded one.  Both requests have already been
+// passed through the recorder's redactors.
+type RequestMatcher func(actual, recorded RecordedRequest) bool
+
+// MatchMethod matches requests with the same HTTP method
+func MatchMethod() RequestMatcher {
+	return func(actual, recorded RecordedRequest) bool {
+		return actual.Method == recorded.Method
+	}
+}
+
+// MatchPath matches requests with the same URL path
+func MatchPath() RequestMatcher {
+	return func(actual, recorded RecordedRequest) bool {
+		au, aErr := url.Parse(actual.URL)
+		ru, rErr := url.Parse(recorded.URL)
+		return aErr == nil && rErr == nil && au.Path == ru.Path
+	}
+}
+
+// MatchQuery matches requests with the same URL query parameters, regardless of order
+func MatchQuery() RequestMatcher {
+	return func(actual, recorded RecordedRequest) bool {
+		au, aErr := url.Parse(actual.URL)
+		ru, rErr := url.Parse(recorded.URL)
+		return aErr == nil && rErr == nil && au.Query().Encode() == ru.Query().Encode()
+	}
+}
+
+// MatchBody matches requests with byte-for-byte identical bodies
+func MatchBody() RequestMatcher {
+	return func(actual, recorded RecordedRequest) bool {
+		return actual.Body == recorded.Body
+	}
+}
+
+// MatchNormalizedBody matches requests whose bodies are equivalent JSON documents, ignoring key order and whitespace.
+// Bodies that are not valid JSON are compared with leading and trailing whitespace removed.
+func MatchNormalizedBody() RequestMatcher {
+	return func(actual, recorded RecordedRequest) bool {
+		return normalizeBody(actual.Body) == normalizeBody(recorded.Body)
+	}
+}
+
+// DefaultRequestMatchers returns the matchers used when a RecorderConfig does not define any
+func DefaultRequestMatchers() []RequestMatcher {
+	return []RequestMatcher{MatchMethod(), MatchPath(), MatchNormalizedBody()}
+}
+
+func normalizeBody(body string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return strings.TrimSpace(body)
+	}
+	// encoding/json sorts map keys when marshalling
+	b, err := json.Marshal(v)
+	if err != nil {
+		return strings.TrimSpace(body)
+	}
+	return string(b)
+}
+
+// Redactor scrubs secrets from an interaction before it is written to or compared against a cassette
+type Redactor func(*Interaction)
+
+// RedactRequestHeaders replaces the value of each named request header with RedactedValue
+func RedactRequestHeaders(names ...string) Redactor {
+	return func(i *Interaction) {
+		redactHeaders(i.Request.Headers, names)
+	}
+}
+
+// RedactResponseHeaders replaces the value of each named response header with RedactedValue
+func RedactResponseHeaders(names ...string) Redactor {
+	return func(i *Interaction) {
+		redactHeaders(i.Response.Headers, names)
+	}
+}
+
+// RedactQueryParams replaces the value of each named request URL query parameter with RedactedValue
+func RedactQueryParams(names ...string) Redactor {
+	return func(i *Interaction) {
+		u, err := url.Parse(i.Request.URL)
+		if err != nil {
+			return
+		}
+		q := u.Query()
+		for _, name := range names {
+			if _, ok := q[name]; ok {
+				q.Set(name, RedactedValue)
+			}
+		}
+		u.RawQuery = q.Encode()
+		i.Request.URL = u.String()
+	}
+}
+
+// RedactJSONFields replaces the value of each named field, at any depth, within JSON request and response bodies
+func RedactJSONFields(names ...string) Redactor {
+	fields := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		fields[name] = struct{}{}
+	}
+	return func(i *Interaction) {
+		i.Request.Body = redactJSONBody(i.Request.Body, fields)
+		i.Response.Body = redactJSONBody(i.Response.Body, fields)
+	}
+}
+
+// RedactRegexp replaces every match of the provided expression within request URLs and bodies, and response bodies,
+// with RedactedValue
+func RedactRegexp(re *regexp.Regexp) Redactor {
+	return func(i *Interaction) {
+		i.Request.URL = re.ReplaceAllString(i.Request.URL, RedactedValue)
+		i.Request.Body = re.ReplaceAllString(i.Request.Body, RedactedValue)
+		i.Response.Body = re.ReplaceAllString(i.Response.Body, RedactedValue)
+	}
+}
+
+func redactHeaders(h http.Header, names []string) {
+	for _, name := range names {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, RedactedValue)
+		}
+	}
+}
+
+func redactJSONBody(body string, fields map[string]struct{}) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+	b, err := json.Marshal(redactJSONValue(v, fields))
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+func redactJSONValue(v interface{}, fields map[string]struct{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		for k, iv := range tv {
+			if _, ok := fields[k]; ok {
+				tv[k] = RedactedValue
+			} else {
+				tv[k] = redactJSONValue(iv, fields)
+			}
+		}
+	case []interface{}:
+		for i, iv := range tv {
+			tv[i] = redactJSONValue(iv, fields)
+		}
+	}
+	return v
+}
+
+// RecorderConfig describes the configuration of a Recorder
+type RecorderConfig struct {
+	// CassettePath is the path to the file interactions are recorded to or replayed from
+	CassettePath string
+	Mode         RecorderMode
+
+	// Transport is used to send requests in RecorderModeOff and RecorderModeRecord.  Defaults to
+	// http.DefaultTransport
+	Transport http.RoundTripper
+
+	// Matchers must all match for a recorded interaction to be replayed.  Defaults to DefaultRequestMatchers
+	Matchers  []RequestMatcher
+	Redactors []Redactor
+}
+
+// Recorder is an http.RoundTripper that records interactions to, or replays them from, a Cassette
+type Recorder struct {
+	mu        sync.Mutex
+	path      string
+	mode      RecorderMode
+	transport http.RoundTripper
+	matchers  []RequestMatcher
+	redactors []Redactor
+	cassette  Cassette
+	used      []bool
+}
+
+// NewRecorder constructs a new Recorder.  In RecorderModeReplay, the cassette is read immediately and an error is
+// returned if it cannot be.
+func NewRecorder(conf RecorderConfig) (*Recorder, error) {
+	r := &Recorder{
+		path:      conf.CassettePath,
+		mode:      conf.Mode,
+		transport: conf.Transport,
+		matchers:  conf.Matchers,
+		redactors: conf.Redactors,
+	}
+
+	if r.mode == "" {
+		r.mode = RecorderModeOff
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	if len(r.matchers) == 0 {
+		r.matchers = DefaultRequestMatchers()
+	}
+
+	switch r.mode {
+	case RecorderModeOff:
+	case RecorderModeRecord:
+		if r.path == "" {
+			return nil, fmt.Errorf("%w: cassette path must be defined when recording", ErrRecorderModeInvalid)
+		}
+	case RecorderModeReplay:
+		b, err := os.ReadFile(r.path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading cassette %q: %w", r.path, err)
+		}
+		if err = json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("error decoding cassette %q: %w", r.path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrRecorderModeInvalid, r.mode)
+	}
+
+	return r, nil
+}
+
+// NewTestRecorder constructs a Recorder storing its cassette at {RecordingsDir}/{name}.json.  If the config does not
+// define a mode, RecorderModeFromEnv is used.  The cassette is written when the test completes.
+func NewTestRecorder(t testing.TB, name string, conf RecorderConfig) *Recorder {
+	t.Helper()
+
+	if conf.Mode == "" {
+		mode, err := RecorderModeFromEnv()
+		if err != nil {
+			t.Fatalf("Error determining recorder mode: %v", err)
+		}
+		conf.Mode = mode
+	}
+	if conf.CassettePath == "" {
+		conf.CassettePath = filepath.Join(RecordingsDir, snapshotNameSanitizer.ReplaceAllString(name, "_")+".json")
+	}
+
+	r, err := NewRecorder(conf)
+	if err != nil {
+		t.Fatalf("Error creating recorder: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Errorf("Error saving cassette: %v", err)
+		}
+	})
+
+	return r
+}
+
+// Mode returns the mode this recorder is operating in
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Client returns an *http.Client using this recorder as its transport
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns a copy of the interactions recorded or loaded so far
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Interaction, len(r.cassette.Interactions))
+	copy(out, r.cassette.Interactions)
+	return out
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case RecorderModeRecord:
+		return r.record(req)
+	case RecorderModeReplay:
+		return r.replay(req)
+
+	default:
+		return r.transport.RoundTrip(req)
+	}
+}
+
+// Stop writes the cassette to disk when recording.  It is a no-op in all other modes.
+func (r *Recorder) Stop() error {
+	if r.mode != RecorderModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding cassette %q: %w", r.path, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("error creating cassette directory for %q: %w", r.path, err)
+	}
+	if err = os.WriteFile(r.path, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing cassette %q: %w", r.path, err)
+	}
+	return nil
+}
+
+func (r *Recorder) redact(i *Interaction) {
+	for _, fn := range r.redactors {
+		fn(i)
+	}
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	// the caller's request must not be modified, so its body is read and handed to the transport on a clone
+	req = req.Clone(req.Context())
+	reqBody, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := drainBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	i := Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: req.Header.Clone(),
+			Body:    string(reqBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header.Clone(),
+			Body:       string(respBody),
+		},
+	}
+	r.redact(&i)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, i)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	clone := req.Clone(req.Context())
+	reqBody, err := drainBody(&clone.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	actual := Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: req.Header.Clone(),
+			Body:    string(reqBody),
+		},
+	}
+	r.redact(&actual)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+outer:
+	for idx, i := range r.cassette.Interactions {
+		if r.used[idx] {
+			continue
+		}
+		for _, fn := range r.matchers {
+			if !fn(actual.Request, i.Request) {
+				continue outer
+			}
+		}
+		r.used[idx] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        i.Response.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: method=%q; url=%q", ErrNoMatchingInteraction, req.Method, actual.Request.URL)
+}
+
+// drainBody reads the entirety of the provided body, replacing it with a new reader over the same bytes
+func drainBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading body: %w", err)
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
@@ -0,0 +1,84 @@
+package acctest_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"path":"` + r.URL.Path + `","token":"hunter2","echo":` + string(b) + `}`))
+	}))
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	redactors := []acctest.Redactor{
+		acctest.RedactRequestHeaders("Authorization"),
+		acctest.RedactJSONFields("token"),
+	}
+
+	do := func(t *testing.T, c *http.Client, body string) (string, error) {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+"/things", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer hunter2")
+		reqBody := req.Body
+		resp, err := c.Do(req)
+		assert.True(t, reqBody == req.Body, "Request body must not be replaced")
+		if err != nil {
+			return "", err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		b, err := io.ReadAll(resp.Body)
+		return string(b), err
+	}
+
+	rec, err := acctest.NewRecorder(acctest.RecorderConfig{
+		CassettePath: cassette,
+		Mode:         acctest.RecorderModeRecord,
+		Redactors:    redactors,
+	})
+	if err != nil {
+		t.Fatalf("Error creating recorder: %v", err)
+	}
+	live, err := do(t, rec.Client(), `{"a": 1, "b": 2}`)
+	if err != nil {
+		t.Fatalf("Error executing recorded request: %v", err)
+	}
+	if err = rec.Stop(); err != nil {
+		t.Fatalf("Error saving cassette: %v", err)
+	}
+	srv.Close()
+
+	b, _ := os.ReadFile(cassette)
+	assert.NotContains(t, string(b), "hunter2", "Cassette contains secret")
+
+	rep, err := acctest.NewRecorder(acctest.RecorderConfig{
+		CassettePath: cassette,
+		Mode:         acctest.RecorderModeReplay,
+		Redactors:    redactors,
+	})
+	if err != nil {
+		t.Fatalf("Error creating replayer: %v", err)
+	}
+
+	replayed, err := do(t, rep.Client(), `{"b":2,"a":1}`)
+	if err != nil {
+		t.Fatalf("Error executing replayed request: %v", err)
+	}
+	assert.JSONEq(t, strings.ReplaceAll(live, "hunter2", acctest.RedactedValue), replayed)
+
+	_, err = do(t, rep.Client(), `{"b":2,"a":1}`)
+	if !acctest.IsNoMatchingInteractionError(err) {
+		t.Logf("Expected no matching interaction error once cassette is exhausted, saw %v", err)
+		t.Fail()
+	}
+}
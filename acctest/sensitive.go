@@ -0,0 +1,75 @@
+package acctest
+
+import (
+	"strings"
+	"testing"
+)
+
+// SensitiveMask replaces the rendered form of every Sensitive value in output produced by RedactConfig
+const SensitiveMask = `"(sensitive)"`
+
+const (
+	// sensitiveOpen and sensitiveClose are HCL comments bracketing each rendered Sensitive value.  Terraform ignores
+	// them, and they allow RedactConfig to mask exactly the sensitive values of a config without ever matching an
+	// unrelated attribute that happens to render the same way.
+	sensitiveOpen  = "/*acctest:sensitive*/"
+	sensitiveClose = "/*acctest:end-sensitive*/"
+)
+
+// Sensitive wraps a config value that must not appear in logs, e.g. a token or password.  It renders normally via
+// ConfigValue, so the config handed to Terraform is unaffected, but the rendered form is bracketed with comments so
+// that RedactConfig, LogConfig, and AssertConfigSnapshot can mask it.
+type Sensitive struct {
+	Value interface{}
+}
+
+// ConfigValue renders the wrapped value, marked for redaction
+func (s Sensitive) ConfigValue() string {
+	return sensitiveOpen + ConfigValue(s.Value) + sensitiveClose
+}
+
+// RedactConfig returns the provided config with the rendered form of every Sensitive value within it replaced with
+// SensitiveMask
+func RedactConfig(conf string) string {
+	var (
+		out   strings.Builder
+		depth int
+	)
+	for len(conf) > 0 {
+		start := strings.Index(conf, sensitiveOpen)
+		end := strings.Index(conf, sensitiveClose)
+		switch {
+		case start >= 0 && (end < 0 || start < end):
+			if depth == 0 {
+				out.WriteString(conf[:start])
+			}
+			depth++
+			conf = conf[start+len(sensitiveOpen):]
+		case end >= 0 && depth > 0:
+			depth--
+			if depth == 0 {
+				out.WriteString(SensitiveMask)
+			}
+			conf = conf[end+len(sensitiveClose):]
+		default:
+			if depth == 0 {
+				out.WriteString(conf)
+			} else {
+				// unterminated marker, mask the remainder rather than leak it
+				out.WriteString(SensitiveMask)
+			}
+			conf = ""
+		}
+	}
+	return out.String()
+}
+
+// LogConfig logs the redacted form of the provided config to the test and returns the config unchanged, allowing it
+// to wrap a TestStep's Config, e.g.:
+//
+//	Config: acctest.LogConfig(t, conf),
+func LogConfig(t testing.TB, conf string) string {
+	t.Helper()
+	t.Log(RedactConfig(conf))
+	return conf
+}
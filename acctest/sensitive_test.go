@@ -0,0 +1,33 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestSensitive(t *testing.T) {
+	conf := acctest.CompileProviderConfig("thing", map[string]interface{}{
+		"address":  "http://example.com",
+		"region":   "us-east-1",
+		"insecure": true,
+		"token":    acctest.Sensitive{Value: "super-secret-token"},
+		"zone":     acctest.Sensitive{Value: "us-east-1"},
+		"verify":   acctest.Sensitive{Value: true},
+	})
+
+	assert.Contains(t, conf, `"super-secret-token"`, "Sensitive values must render into the config")
+
+	redacted := acctest.RedactConfig(conf)
+	assert.NotContains(t, redacted, "super-secret-token")
+	assert.Contains(t, redacted, acctest.SensitiveMask)
+	assert.Contains(t, redacted, `"http://example.com"`)
+	assert.Contains(t, redacted, `"us-east-1"`, "Unrelated attributes rendering like a sensitive value must not be masked")
+	assert.Contains(t, redacted, "true", "Unrelated attributes rendering like a sensitive value must not be masked")
+
+	assert.Equal(t, conf, acctest.LogConfig(t, conf))
+
+	acctest.AssertConfigSnapshot(t, "", conf)
+}
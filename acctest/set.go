@@ -0,0 +1,38 @@
+package acctest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Set may be used to render an unordered collection deterministically.  Elements are rendered with ConfigValue, then
+// deduplicated and sorted by their rendered representation.
+type Set[T any] []T
+
+func (s Set[T]) ConfigValue() string {
+	return fmt.Sprintf("[\n%s\n]", strings.Join(renderSetElements(s), ",\n"))
+}
+
+// ToSet renders the same as Set, additionally wrapped in a call to toset() so that the value is converted to a set
+// type by Terraform.
+type ToSet[T any] []T
+
+func (s ToSet[T]) ConfigValue() string {
+	return fmt.Sprintf("toset(%s)", Set[T](s).ConfigValue())
+}
+
+func renderSetElements[T any](elems []T) []string {
+	seen := make(map[string]struct{}, len(elems))
+	out := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		v := ConfigValue(elem)
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,107 @@
+package acctest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	// SnapshotUpdateEnv may be set to any truthy value to rewrite golden files rather than compare against them
+	SnapshotUpdateEnv = "ACCTEST_UPDATE_SNAPSHOTS"
+
+	// SnapshotFileExtension is appended to the snapshot name to construct the golden file name
+	SnapshotFileExtension = ".golden"
+)
+
+// SnapshotDir is the directory, relative to the test's working directory, golden files are read from and written to
+var SnapshotDir = "testdata"
+
+var snapshotNameSanitizer = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// SnapshotUpdateEnabled returns true if golden files should be rewritten.  This is the case when either an -update
+// flag registered by the test package itself, or the SnapshotUpdateEnv environment variable, is set.  No flag is
+// registered by this package, so that binaries importing it are unaffected.
+func SnapshotUpdateEnabled() bool {
+	if f := flag.Lookup("update"); f != nil {
+		if b, err := strconv.ParseBool(f.Value.String()); err == nil && b {
+			return true
+		}
+	}
+	if b, err := strconv.ParseBool(os.Getenv(SnapshotUpdateEnv)); err == nil && b {
+		return true
+	}
+	return false
+}
+
+// SnapshotPath returns the path to the golden file for the named snapshot
+func SnapshotPath(name string) string {
+	return filepath.Join(SnapshotDir, snapshotNameSanitizer.ReplaceAllString(name, "_")+SnapshotFileExtension)
+}
+
+// FormatConfig returns the canonically formatted representation of the provided config
+func FormatConfig(conf string) string {
+	out := string(hclwrite.Format([]byte(strings.TrimSpace(conf))))
+	return fmt.Sprintf("%s\n", strings.TrimRight(out, "\n"))
+}
+
+// AssertConfigSnapshot formats the provided config and compares it against the golden file for the named snapshot,
+// failing the test and logging a unified diff if they differ.  If name is empty, the test's name is used.  Sensitive
+// values are masked before comparison, so they are never written to golden files.
+//
+// When SnapshotUpdateEnabled returns true, the golden file is rewritten instead.
+func AssertConfigSnapshot(t testing.TB, name, conf string) bool {
+	t.Helper()
+
+	if name == "" {
+		name = t.Name()
+	}
+
+	actual := FormatConfig(RedactConfig(conf))
+	fpath := SnapshotPath(name)
+
+	if SnapshotUpdateEnabled() {
+		if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+			t.Fatalf("Error creating snapshot directory for %q: %v", fpath, err)
+		}
+		if err := os.WriteFile(fpath, []byte(actual), 0644); err != nil {
+			t.Fatalf("Error writing snapshot %q: %v", fpath, err)
+		}
+		t.Logf("Updated snapshot %q", fpath)
+		return true
+	}
+
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		t.Errorf("Error reading snapshot %q, run with %s=1 or an -update flag to create it: %v", fpath, SnapshotUpdateEnv, err)
+		return false
+	}
+
+	expected := string(b)
+	if expected == actual {
+		return true
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(expected),
+		B:        difflib.SplitLines(actual),
+		FromFile: fpath,
+		ToFile:   "actual",
+		Context:  3,
+	})
+	if err != nil {
+		t.Errorf("Config does not match snapshot %q, additionally an error occurred building diff: %v", fpath, err)
+		return false
+	}
+
+	t.Errorf("Config does not match snapshot %q:\n%s", fpath, diff)
+	return false
+}
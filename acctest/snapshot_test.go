@@ -0,0 +1,25 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestAssertConfigSnapshot(t *testing.T) {
+	conf := acctest.JoinConfigs(
+		acctest.CompileProviderConfig("thing", map[string]interface{}{
+			"address": "http://example.com",
+			"retries": 3,
+		}),
+		acctest.CompileResourceConfig("thing_fish", "test", map[string]interface{}{
+			"name": "fish",
+			"tags": map[string]string{"b": "2", "a": "1"},
+			"sizes": []int{
+				1,
+				2,
+			},
+		}),
+	)
+	acctest.AssertConfigSnapshot(t, "", conf)
+}
@@ -0,0 +1,223 @@
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+const (
+	// StateFormatVersion is the version of the state file format produced by StateBuilder
+	StateFormatVersion = 4
+
+	// DefaultStateTerraformVersion is the terraform_version written to state when one is not defined
+	DefaultStateTerraformVersion = "1.9.0"
+
+	StateModeManaged = "managed"
+	StateModeData    = "data"
+)
+
+// StateInstance describes a single instance of a resource in state.
+//
+// Attributes and Identity may be either a map[string]interface{}, or any other value that can be encoded by
+// encoding/json, or an attr.Value.
+type StateInstance struct {
+	// IndexKey is the count index or for_each key of the instance, if any
+	IndexKey      interface{}
+	SchemaVersion int64
+	Attributes    interface{}
+	Private       []byte
+
+	IdentitySchemaVersion int64
+	Identity              interface{}
+}
+
+// RawState returns the instance's attributes as the RawState payload provided to UpgradeState and MoveState
+func (i StateInstance) RawState(ctx context.Context) (*tfprotov6.RawState, error) {
+	return RawStateFromValue(ctx, i.Attributes)
+}
+
+// RawIdentity returns the instance's identity as a RawState payload, or nil if the instance has no identity
+func (i StateInstance) RawIdentity(ctx context.Context) (*tfprotov6.RawState, error) {
+	if i.Identity == nil {
+		return nil, nil
+	}
+	return RawStateFromValue(ctx, i.Identity)
+}
+
+// UpgradeStateRequest returns a request suitable for passing directly to a resource.StateUpgrader's StateUpgrader
+// func.  The State field is left empty, as it is only populated by the framework when a PriorSchema is defined.
+func (i StateInstance) UpgradeStateRequest(ctx context.Context) (resource.UpgradeStateRequest, error) {
+	raw, err := i.RawState(ctx)
+	if err != nil {
+		return resource.UpgradeStateRequest{}, err
+	}
+	return resource.UpgradeStateRequest{RawState: raw}, nil
+}
+
+// MoveStateRequest returns a request suitable for passing directly to a resource.StateMover's StateMover func.  The
+// SourceState and SourcePrivate fields are left empty, as they are only populated by the framework.
+func (i StateInstance) MoveStateRequest(ctx context.Context, sourceTypeName, sourceProviderAddress string) (resource.MoveStateRequest, error) {
+	raw, err := i.RawState(ctx)
+	if err != nil {
+		return resource.MoveStateRequest{}, err
+	}
+	identity, err := i.RawIdentity(ctx)
+	if err != nil {
+		return resource.MoveStateRequest{}, err
+	}
+	return resource.MoveStateRequest{
+		SourceProviderAddress:       sourceProviderAddress,
+		SourceRawState:              raw,
+		SourceSchemaVersion:         i.SchemaVersion,
+		SourceTypeName:              sourceTypeName,
+		SourceIdentity:              identity,
+		SourceIdentitySchemaVersion: i.IdentitySchemaVersion,
+	}, nil
+}
+
+// StateResource describes a single resource in state
+type StateResource struct {
+	// Mode is either StateModeManaged or StateModeData.  Defaults to StateModeManaged
+	Mode string
+	Type string
+	Name string
+	// Provider is the source address of the provider, e.g. "registry.terraform.io/hashicorp/aws"
+	Provider  string
+	Instances []StateInstance
+}
+
+// StateBuilder produces version 4 tfstate JSON documents
+type StateBuilder struct {
+	TerraformVersion string
+	Serial           int64
+	Lineage          string
+
+	resources []StateResource
+}
+
+// NewStateBuilder returns a StateBuilder with its TerraformVersion set to DefaultStateTerraformVersion
+func NewStateBuilder() *StateBuilder {
+	return &StateBuilder{
+		TerraformVersion: DefaultStateTerraformVersion,
+		Serial:           1,
+	}
+}
+
+// AddResource appends a resource to the state
+func (b *StateBuilder) AddResource(r StateResource) *StateBuilder {
+	b.resources = append(b.resources, r)
+	return b
+}
+
+type stateFileInstance struct {
+	IndexKey              interface{}   `json:"index_key,omitempty"`
+	SchemaVersion         int64         `json:"schema_version"`
+	Attributes            interface{}   `json:"attributes"`
+	SensitiveAttributes   []interface{} `json:"sensitive_attributes"`
+	Private               []byte        `json:"private,omitempty"`
+	IdentitySchemaVersion *int64        `json:"identity_schema_version,omitempty"`
+	Identity              interface{}   `json:"identity,omitempty"`
+}
+
+type stateFileResource struct {
+	Mode      string              `json:"mode"`
+	Type      string              `json:"type"`
+	Name      string              `json:"name"`
+	Provider  string              `json:"provider"`
+	Instances []stateFileInstance `json:"instances"`
+}
+
+type stateFile struct {
+	Version          int                    `json:"version"`
+	TerraformVersion string                 `json:"terraform_version"`
+	Serial           int64                  `json:"serial"`
+	Lineage          string                 `json:"lineage"`
+	Outputs          map[string]interface{} `json:"outputs"`
+	Resources        []stateFileResource    `json:"resources"`
+	CheckResults     interface{}            `json:"check_results"`
+}
+
+// JSON renders the state as a tfstate v4 document
+func (b *StateBuilder) JSON(ctx context.Context) ([]byte, error) {
+	sf := stateFile{
+		Version:          StateFormatVersion,
+		TerraformVersion: b.TerraformVersion,
+		Serial:           b.Serial,
+		Lineage:          b.Lineage,
+		Outputs:          make(map[string]interface{}),
+		Resources:        make([]stateFileResource, len(b.resources)),
+	}
+
+	for ri, r := range b.resources {
+		sr := stateFileResource{
+			Mode:      r.Mode,
+			Type:      r.Type,
+			Name:      r.Name,
+			Provider:  fmt.Sprintf("provider[%q]", r.Provider),
+			Instances: make([]stateFileInstance, len(r.Instances)),
+		}
+		if sr.Mode == "" {
+			sr.Mode = StateModeManaged
+		}
+
+		for ii, inst := range r.Instances {
+			attrs, err := stateJSONValue(ctx, inst.Attributes)
+			if err != nil {
+				return nil, fmt.Errorf("error converting attributes of %s.%s[%d]: %w", r.Type, r.Name, ii, err)
+			}
+			si := stateFileInstance{
+				IndexKey:            inst.IndexKey,
+				SchemaVersion:       inst.SchemaVersion,
+				Attributes:          attrs,
+				SensitiveAttributes: make([]interface{}, 0),
+				Private:             inst.Private,
+			}
+			if inst.Identity != nil {
+				identity, err := stateJSONValue(ctx, inst.Identity)
+				if err != nil {
+					return nil, fmt.Errorf("error converting identity of %s.%s[%d]: %w", r.Type, r.Name, ii, err)
+				}
+				si.Identity = identity
+				si.IdentitySchemaVersion = &inst.IdentitySchemaVersion
+			}
+			sr.Instances[ii] = si
+		}
+
+		sf.Resources[ri] = sr
+	}
+
+	return json.MarshalIndent(sf, "", "  ")
+}
+
+// RawStateFromValue encodes the provided value as the RawState payload provided to UpgradeState and MoveState.  The
+// value may be an attr.Value or any value that can be encoded by encoding/json, typically a map[string]interface{}.
+func RawStateFromValue(ctx context.Context, v interface{}) (*tfprotov6.RawState, error) {
+	jv, err := stateJSONValue(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(jv)
+	if err != nil {
+		return nil, err
+	}
+	return &tfprotov6.RawState{JSON: b}, nil
+}
+
+func stateJSONValue(ctx context.Context, v interface{}) (interface{}, error) {
+	av, ok := v.(attr.Value)
+	if !ok {
+		return v, nil
+	}
+	tv, err := av.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return util.TerraformValueToJSONable(tv)
+}
@@ -0,0 +1,65 @@
+package acctest_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestStateBuilder(t *testing.T) {
+	ctx := context.Background()
+
+	attrs := types.ObjectValueMust(
+		map[string]attr.Type{"id": types.StringType, "size": types.Int64Type, "tags": types.ListType{ElemType: types.StringType}},
+		map[string]attr.Value{
+			"id":   types.StringValue("abc"),
+			"size": types.Int64Value(5),
+			"tags": types.ListNull(types.StringType),
+		},
+	)
+
+	inst := acctest.StateInstance{
+		SchemaVersion: 1,
+		Attributes:    attrs,
+		Private:       []byte(`{"etag":"1"}`),
+		Identity:      map[string]interface{}{"id": "abc"},
+	}
+
+	b, err := acctest.NewStateBuilder().AddResource(acctest.StateResource{
+		Type:      "thing_fish",
+		Name:      "test",
+		Provider:  "registry.terraform.io/thing/thing",
+		Instances: []acctest.StateInstance{inst},
+	}).JSON(ctx)
+	if err != nil {
+		t.Fatalf("Error building state: %v", err)
+	}
+
+	var state map[string]interface{}
+	if err = json.Unmarshal(b, &state); err != nil {
+		t.Fatalf("Error decoding state: %v", err)
+	}
+	assert.EqualValues(t, 4, state["version"])
+	res := state["resources"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, `provider["registry.terraform.io/thing/thing"]`, res["provider"])
+	instance := res["instances"].([]interface{})[0].(map[string]interface{})
+	assert.EqualValues(t, 1, instance["schema_version"])
+	assert.Equal(t, map[string]interface{}{"id": "abc", "size": float64(5), "tags": nil}, instance["attributes"])
+
+	req, err := inst.UpgradeStateRequest(ctx)
+	if err != nil {
+		t.Fatalf("Error building upgrade request: %v", err)
+	}
+	tv, err := req.RawState.Unmarshal(attrs.Type(ctx).TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("Error unmarshalling raw state: %v", err)
+	}
+	expected, _ := attrs.ToTerraformValue(ctx)
+	assert.True(t, expected.Equal(tv), "Expected %s, saw %s", expected, tv)
+}
@@ -0,0 +1,235 @@
+package acctest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// SweepRequest is provided to each SweeperFunc
+type SweepRequest struct {
+	// Target is the value of the -sweep flag, typically a region or account to sweep
+	Target string
+	// Prefix limits sweeping to resources whose name begins with it
+	Prefix string
+	// DryRun indicates that resources should be reported, but not deleted
+	DryRun bool
+}
+
+// ShouldSweep returns true if the named resource should be swept under this request
+func (r SweepRequest) ShouldSweep(name string) bool {
+	return strings.HasPrefix(name, r.Prefix)
+}
+
+// Sweep calls fn to delete the named resource if ShouldSweep returns true.  In dry-run mode, the resource is logged
+// and fn is not called.
+func (r SweepRequest) Sweep(name string, fn func() error) error {
+	if !r.ShouldSweep(name) {
+		return nil
+	}
+	if r.DryRun {
+		log.Printf("[DEBUG] [acctest] Dry run, would sweep %q", name)
+		return nil
+	}
+	log.Printf("[DEBUG] [acctest] Sweeping %q", name)
+	return fn()
+}
+
+// SweeperFunc deletes leaked resources of a single type
+type SweeperFunc func(ctx context.Context, req SweepRequest) error
+
+// Sweeper describes how to clean up leaked resources of a single type
+type Sweeper struct {
+	// Name is the resource type this sweeper cleans up.  It is set by AddSweeper.
+	Name string
+	// Dependencies are the names of sweepers that must run before this one, e.g. a network sweeper would depend on
+	// the sweeper for instances attached to that network.
+	Dependencies []string
+	F            SweeperFunc
+}
+
+var (
+	sweepersMu sync.RWMutex
+	sweepers   = make(map[string]Sweeper)
+)
+
+// AddSweeper registers a sweeper for the provided resource type.  It panics if a sweeper is already registered for the
+// type.
+func AddSweeper(resourceType string, s Sweeper) {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+	if _, ok := sweepers[resourceType]; ok {
+		panic(fmt.Sprintf("sweeper for %q already registered", resourceType))
+	}
+	s.Name = resourceType
+	sweepers[resourceType] = s
+}
+
+// GetSweeper returns the sweeper registered for the provided resource type
+func GetSweeper(resourceType string) (Sweeper, bool) {
+	sweepersMu.RLock()
+	defer sweepersMu.RUnlock()
+	s, ok := sweepers[resourceType]
+	return s, ok
+}
+
+// RemoveSweeper unregisters the sweeper for the provided resource type, if any, allowing it to be registered again
+func RemoveSweeper(resourceType string) {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+	delete(sweepers, resourceType)
+}
+
+// SweeperOrder returns the names of the provided sweepers, and all of their dependencies, in the order they must be
+// run.  If no names are provided, every registered sweeper is ordered.
+func SweeperOrder(names ...string) ([]string, error) {
+	sweepersMu.RLock()
+	defer sweepersMu.RUnlock()
+
+	if len(names) == 0 {
+		for name := range sweepers {
+			names = append(names, name)
+		}
+	}
+	names = append([]string(nil), names...)
+	sort.Strings(names)
+
+	var (
+		out     = make([]string, 0)
+		visited = make(map[string]bool)
+
+		visit func(name string, path []string) error
+	)
+
+	visit = func(name string, path []string) error {
+		for _, p := range path {
+			if p == name {
+				return fmt.Errorf("%w: %s", ErrSweeperDependencyCycle, strings.Join(append(path, name), " -> "))
+			}
+		}
+		if visited[name] {
+			return nil
+		}
+		s, ok := sweepers[name]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrSweeperNotFound, name)
+		}
+		deps := append([]string(nil), s.Dependencies...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		out = append(out, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// SweepOptions describes a single execution of RunSweepers
+type SweepOptions struct {
+	SweepRequest
+
+	// Run limits execution to the named sweepers and their dependencies.  If empty, all sweepers are run.
+	Run []string
+	// AllowFailures continues running sweepers after one has failed
+	AllowFailures bool
+}
+
+// RunSweepers runs the requested sweepers in dependency order.  If opts.Prefix is empty, NamePrefix is used.
+func RunSweepers(ctx context.Context, opts SweepOptions) error {
+	if opts.Prefix == "" {
+		opts.Prefix = NamePrefix
+	}
+
+	order, err := SweeperOrder(opts.Run...)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range order {
+		s, _ := GetSweeper(name)
+		if s.F == nil {
+			continue
+		}
+		log.Printf("[INFO] [acctest] Running sweeper %q (target=%q; prefix=%q; dry_run=%t)", name, opts.Target, opts.Prefix, opts.DryRun)
+		if err := s.F(ctx, opts.SweepRequest); err != nil {
+			err = fmt.Errorf("%w: sweeper=%q; err=%v", ErrSweeperFailed, name, err)
+			if !opts.AllowFailures {
+				return err
+			}
+			log.Printf("[ERROR] [acctest] %v", err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepFlag returns the existing flag with the provided name, defining it if necessary.  This allows SweepMain to be
+// used alongside other packages that define the same flags.
+func sweepFlag(name, value, usage string) flag.Value {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value
+	}
+	flag.String(name, value, usage)
+	return flag.Lookup(name).Value
+}
+
+// SweepMain may be called from TestMain.  When the test binary is run with -sweep, the registered sweepers are run
+// instead of the tests, e.g.:
+//
+//	go test ./... -v -sweep=us-east-1 -sweep-run=my_instance -sweep-dry-run=true
+//
+// Otherwise, the tests are run as normal.  This function does not return.
+func SweepMain(m *testing.M) {
+	var (
+		target        = sweepFlag("sweep", "", "Sweep leaked resources for the provided target, e.g. a region, rather than running tests")
+		run           = sweepFlag("sweep-run", "", "Comma-separated list of sweepers to run, along with their dependencies")
+		allowFailures = sweepFlag("sweep-allow-failures", "false", "Continue running sweepers after one has failed")
+		dryRun        = sweepFlag("sweep-dry-run", "false", "Report resources that would be swept without deleting them")
+		prefix        = sweepFlag("sweep-prefix", "", "Only sweep resources whose name begins with this prefix, defaults to the acctest name prefix")
+	)
+
+	flag.Parse()
+
+	if target.String() == "" {
+		os.Exit(m.Run())
+	}
+
+	opts := SweepOptions{
+		SweepRequest: SweepRequest{
+			Target: target.String(),
+			Prefix: prefix.String(),
+		},
+	}
+	opts.DryRun, _ = strconv.ParseBool(dryRun.String())
+	opts.AllowFailures, _ = strconv.ParseBool(allowFailures.String())
+	if run.String() != "" {
+		opts.Run = strings.Split(run.String(), ",")
+	}
+
+	if err := RunSweepers(context.Background(), opts); err != nil {
+		log.Printf("[ERROR] [acctest] Error running sweepers: %v", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
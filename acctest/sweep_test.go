@@ -0,0 +1,73 @@
+package acctest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+)
+
+func TestRunSweepers(t *testing.T) {
+	var (
+		ran     = make([]string, 0)
+		deleted = make([]string, 0)
+		names   = []string{acctest.RandomName(), "not-a-test-resource", acctest.RandomName()}
+	)
+
+	sweeper := func(name string) acctest.SweeperFunc {
+		return func(_ context.Context, req acctest.SweepRequest) error {
+			ran = append(ran, name)
+			for _, n := range names {
+				if err := req.Sweep(n, func() error {
+					deleted = append(deleted, n)
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	t.Cleanup(func() {
+		for _, name := range []string{"network", "instance", "address", "cycle_a", "cycle_b"} {
+			acctest.RemoveSweeper("sweep_test_" + name)
+		}
+	})
+
+	acctest.AddSweeper("sweep_test_network", acctest.Sweeper{
+		Dependencies: []string{"sweep_test_instance", "sweep_test_address"},
+		F:            sweeper("network"),
+	})
+	acctest.AddSweeper("sweep_test_instance", acctest.Sweeper{
+		Dependencies: []string{"sweep_test_address"},
+		F:            sweeper("instance"),
+	})
+	acctest.AddSweeper("sweep_test_address", acctest.Sweeper{F: sweeper("address")})
+	acctest.AddSweeper("sweep_test_cycle_a", acctest.Sweeper{Dependencies: []string{"sweep_test_cycle_b"}})
+	acctest.AddSweeper("sweep_test_cycle_b", acctest.Sweeper{Dependencies: []string{"sweep_test_cycle_a"}})
+
+	err := acctest.RunSweepers(context.Background(), acctest.SweepOptions{
+		Run:          []string{"sweep_test_network"},
+		SweepRequest: acctest.SweepRequest{DryRun: true},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error running sweepers: %v", err)
+	}
+	assert.Equal(t, []string{"address", "instance", "network"}, ran)
+	assert.Empty(t, deleted, "Dry run should not delete")
+
+	err = acctest.RunSweepers(context.Background(), acctest.SweepOptions{Run: []string{"sweep_test_address"}})
+	if err != nil {
+		t.Fatalf("Unexpected error running sweepers: %v", err)
+	}
+	assert.Equal(t, []string{names[0], names[2]}, deleted)
+
+	_, err = acctest.SweeperOrder("sweep_test_cycle_a")
+	if !acctest.IsSweeperDependencyCycleError(err) {
+		t.Logf("Expected dependency cycle error, saw %v", err)
+		t.Fail()
+	}
+}
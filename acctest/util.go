@@ -1,5 +1,7 @@
 package acctest
 
+import "sort"
+
 func MergeMaps(maps ...map[string]interface{}) map[string]interface{} {
 	out := make(map[string]interface{})
 	for _, m := range maps {
@@ -13,3 +15,13 @@ func MergeMaps(maps ...map[string]interface{}) map[string]interface{} {
 func MergeMapsRooted(root map[string]interface{}, maps ...map[string]interface{}) map[string]interface{} {
 	return MergeMaps(append([]map[string]interface{}{root}, maps...)...)
 }
+
+// SortedKeys returns the keys of the provided map in ascending order
+func SortedKeys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
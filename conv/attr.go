@@ -32,6 +32,16 @@ func FormatPathPaths(paths ...path.Path) string {
 
 // FormatAttributePathSteps takes one or more path steps and joins them together with "."
 func FormatAttributePathSteps(pathSteps ...tftypes.AttributePathStep) string {
+	out, err := TryFormatAttributePathSteps(pathSteps...)
+	if err != nil {
+		// if this is reached, a new path step implementation has been created
+		panic(fmt.Sprintf("%v, please create issue with this error message", err))
+	}
+	return out
+}
+
+// TryFormatAttributePathSteps is the non-panicking form of FormatAttributePathSteps
+func TryFormatAttributePathSteps(pathSteps ...tftypes.AttributePathStep) (string, error) {
 	bits := make([]string, 0)
 	for _, pathStep := range pathSteps {
 		switch pathStep.(type) {
@@ -45,21 +55,34 @@ func FormatAttributePathSteps(pathSteps ...tftypes.AttributePathStep) string {
 			bits = append(bits, (tftypes.Value)(pathStep.(tftypes.ElementKeyValue)).String())
 
 		default:
-			// if this is reached, a new path step implementation has been created
-			panic(fmt.Sprintf("no case to convert type %T (%[1]v) to string, please create issue with this error message", pathStep))
+			return "", fmt.Errorf("%w: scope=%q; type=%T", ErrValueTypeUnhandled, "format_attribute_path_steps", pathStep)
 		}
 	}
-	return strings.Join(bits, ".")
+	return strings.Join(bits, "."), nil
 }
 
 // FormatAttributePaths takes one or more *tftypes.AttributePaths and returns a pretty-printable string.
 func FormatAttributePaths(paths ...*tftypes.AttributePath) string {
+	out, err := TryFormatAttributePaths(paths...)
+	if err != nil {
+		// if this is reached, a new path step implementation has been created
+		panic(fmt.Sprintf("%v, please create issue with this error message", err))
+	}
+	return out
+}
+
+// TryFormatAttributePaths is the non-panicking form of FormatAttributePaths
+func TryFormatAttributePaths(paths ...*tftypes.AttributePath) (string, error) {
 	out := "["
 	for i, o := range paths {
 		if i > 0 {
 			out = fmt.Sprintf("%s, ", out)
 		}
-		out = fmt.Sprintf("%s%q", out, FormatAttributePathSteps(o.Steps()...))
+		steps, err := TryFormatAttributePathSteps(o.Steps()...)
+		if err != nil {
+			return "", fmt.Errorf("[%d]: %w", i, err)
+		}
+		out = fmt.Sprintf("%s%q", out, steps)
 	}
-	return fmt.Sprintf("%s]", out)
+	return fmt.Sprintf("%s]", out), nil
 }
@@ -0,0 +1,36 @@
+package conv_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+type customPathStep struct {
+	tftypes.AttributeName
+}
+
+func TestFormatAttributePathSteps(t *testing.T) {
+	out, err := conv.TryFormatAttributePathSteps(tftypes.AttributeName("rules"), tftypes.ElementKeyInt(1), tftypes.ElementKeyString("name"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "rules.1.name", out)
+	}
+
+	_, err = conv.TryFormatAttributePathSteps(customPathStep{AttributeName: "rules"})
+	assert.True(t, conv.IsValueTypeUnhandledError(err), "Expected ErrValueTypeUnhandled, saw %v", err)
+
+	paths := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("rules").WithElementKeyInt(0),
+		tftypes.NewAttributePathWithSteps([]tftypes.AttributePathStep{customPathStep{AttributeName: "rules"}}),
+	}
+	out, err = conv.TryFormatAttributePaths(paths[0])
+	if assert.NoError(t, err) {
+		assert.Equal(t, `["rules.0"]`, out)
+	}
+	_, err = conv.TryFormatAttributePaths(paths...)
+	assert.True(t, conv.IsValueTypeUnhandledError(err), "Expected ErrValueTypeUnhandled, saw %v", err)
+	assert.Panics(t, func() { conv.FormatAttributePaths(paths...) })
+}
@@ -0,0 +1,120 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ToBaseValue returns the base type literal of the provided value, panicking if the conversion fails.  See
+// TryToBaseValue for details.
+func ToBaseValue(v attr.Value) attr.Value {
+	return must(TryToBaseValue(v))
+}
+
+// TryToBaseValue returns the base type literal of the provided value, e.g. a types.String for a *types.String or for a
+// custom type implementing basetypes.StringValuable, such as timetypes.RFC3339.  Values not implementing any of the
+// basetypes Valuable interfaces are returned as-is.
+//
+// An error wrapping ErrValueTypeUnhandled is returned for nil values and nil pointers.
+func TryToBaseValue(v attr.Value) (attr.Value, error) {
+	if v == nil {
+		return nil, ValueTypeUnhandledError("to_base_value", v)
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, ValueTypeUnhandledError("to_base_value", v)
+		}
+		if ev, ok := rv.Elem().Interface().(attr.Value); ok {
+			return TryToBaseValue(ev)
+		}
+	}
+
+	ctx := context.Background()
+	switch vv := v.(type) {
+	case basetypes.BoolValuable:
+		bv, diags := vv.ToBoolValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.Float32Valuable:
+		bv, diags := vv.ToFloat32Value(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.Float64Valuable:
+		bv, diags := vv.ToFloat64Value(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.Int32Valuable:
+		bv, diags := vv.ToInt32Value(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.Int64Valuable:
+		bv, diags := vv.ToInt64Value(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.NumberValuable:
+		bv, diags := vv.ToNumberValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.StringValuable:
+		bv, diags := vv.ToStringValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.ListValuable:
+		bv, diags := vv.ToListValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.MapValuable:
+		bv, diags := vv.ToMapValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.SetValuable:
+		bv, diags := vv.ToSetValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.ObjectValuable:
+		bv, diags := vv.ToObjectValue(ctx)
+		return valuableToBase(v, bv, diags)
+	case basetypes.DynamicValuable:
+		bv, diags := vv.ToDynamicValue(ctx)
+		return valuableToBase(v, bv, diags)
+
+	default:
+		return v, nil
+	}
+}
+
+func valuableToBase[T attr.Value](src attr.Value, bv T, diags diag.Diagnostics) (attr.Value, error) {
+	if diags.HasError() {
+		return nil, fmt.Errorf("error converting %T to %T: %v", src, bv, diags.Errors())
+	}
+	return bv, nil
+}
+
+// valueToBaseType converts v to its base type literal via TryToBaseValue, asserting that the result, or the concrete
+// value of a types.Dynamic result, is a T.
+func valueToBaseType[T attr.Value](scope string, v attr.Value) (T, error) {
+	var zero T
+	bv, err := TryToBaseValue(v)
+	if err != nil {
+		if IsValueTypeUnhandledError(err) {
+			return zero, ValueTypeUnhandledError(scope, v)
+		}
+		return zero, err
+	}
+	if tv, ok := bv.(T); ok {
+		return tv, nil
+	}
+	if _, ok := bv.(types.Dynamic); ok {
+		if uv, err := TryDynamicToUnderlyingValue(bv); err == nil {
+			if tv, ok := uv.(T); ok {
+				return tv, nil
+			}
+		}
+	}
+	return zero, ValueTypeUnhandledError(scope, v)
+}
+
+// baseValueOrSelf returns the base type literal of v if it can be determined, otherwise v as-is.
+func baseValueOrSelf(v attr.Value) attr.Value {
+	if bv, err := TryToBaseValue(v); err == nil {
+		return bv
+	}
+	return v
+}
@@ -0,0 +1,73 @@
+package conv_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+type customString struct {
+	basetypes.StringValue
+}
+
+type customList struct {
+	basetypes.ListValue
+}
+
+func TestToBaseValue(t *testing.T) {
+	str := types.StringValue("hi")
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})
+
+	theTests := []struct {
+		name string
+		in   attr.Value
+		exp  attr.Value
+	}{
+		{name: "literal", in: str, exp: str},
+		{name: "pointer", in: &str, exp: str},
+		{name: "custom-string", in: customString{str}, exp: str},
+		{name: "custom-string-pointer", in: &customString{str}, exp: str},
+		{name: "custom-list", in: customList{list}, exp: list},
+		{name: "dynamic", in: types.DynamicValue(str), exp: types.DynamicValue(str)},
+	}
+
+	for _, tt := range theTests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := conv.TryToBaseValue(tt.in)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.exp, out)
+			}
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		var nilString *customString
+		_, err := conv.TryToBaseValue(nilString)
+		assert.True(t, conv.IsValueTypeUnhandledError(err))
+	})
+}
+
+func TestCustomTypeConversions(t *testing.T) {
+	str := customString{types.StringValue("hi")}
+	list := customList{types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})}
+
+	assert.Equal(t, types.StringValue("hi"), conv.ValueToStringType(str))
+	assert.Equal(t, "hi", conv.AttributeValueToString(str))
+	assert.Equal(t, 2, conv.AttributeValueLength(str))
+	assert.Equal(t, []string{"a", "b"}, conv.StringListToStrings(list))
+	assert.Equal(t, []string{"a", "b"}, conv.AttributeValueToStrings(list))
+	assert.Equal(t, 2, conv.AttributeValueLength(list))
+
+	_, err := conv.TryValueToListType(str)
+	assert.True(t, conv.IsValueTypeUnhandledError(err))
+
+	assert.NoError(t, conv.TestAttributeValueState(str))
+	assert.True(t, conv.IsValueIsEmptyError(conv.TestAttributeValueState(customString{types.StringValue("")})))
+	assert.True(t, conv.IsValueIsNullError(conv.TestAttributeValueState(customList{types.ListNull(types.StringType)})))
+	assert.True(t, conv.IsValueIsUnknownError(conv.TestAttributeValueState(customString{types.StringUnknown()})))
+}
@@ -0,0 +1,84 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+type CtyMark string
+
+// CtySensitiveMark is the mark applied to cty values by MarkCtySensitive
+const CtySensitiveMark CtyMark = "sensitive"
+
+// ToCtyValue converts the provided attr.Value into a cty.Value, panicking on error.  See TryToCtyValue for details.
+func ToCtyValue(v attr.Value) cty.Value {
+	return must(TryToCtyValue(v))
+}
+
+// TryToCtyValue converts the provided attr.Value into a cty.Value of the equivalent type, e.g. a types.Object becomes
+// a cty object value and a types.Tuple a cty tuple value.  Null and unknown values are preserved at every level of
+// nesting.
+func TryToCtyValue(v attr.Value) (cty.Value, error) {
+	tv, err := TryToTerraformValue(v)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	cv, err := util.TerraformValueToCtyValue(tv)
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("cannot convert %T to cty.Value: %w", v, err)
+	}
+	return cv, nil
+}
+
+// FromCtyValue converts the provided cty.Value into an attr.Value of the provided type, panicking on error.  See
+// TryFromCtyValue for details.
+func FromCtyValue(cv cty.Value, typ attr.Type) attr.Value {
+	return must(TryFromCtyValue(cv, typ))
+}
+
+// TryFromCtyValue converts the provided cty.Value, such as the result of evaluating an HCL expression, into an
+// attr.Value of the provided type.  Null and unknown values are preserved at every level of nesting.  Marks, including
+// CtySensitiveMark, are removed; use IsCtySensitive beforehand if they are significant.
+//
+// Use types.DynamicType to convert a value whose type is not known ahead of time.
+func TryFromCtyValue(cv cty.Value, typ attr.Type) (attr.Value, error) {
+	cv, _ = cv.UnmarkDeep()
+	tv, err := util.CtyValueToTerraformValue(cv, typ.TerraformType(context.Background()))
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert cty.Value to %s: %w", typ, err)
+	}
+	return TryFromTerraformValue(tv, typ)
+}
+
+// MarkCtySensitive returns a copy of the provided cty.Value marked with CtySensitiveMark
+func MarkCtySensitive(cv cty.Value) cty.Value {
+	return cv.Mark(CtySensitiveMark)
+}
+
+// IsCtySensitive returns true if the provided cty.Value, or any value within it, is marked with CtySensitiveMark
+func IsCtySensitive(cv cty.Value) bool {
+	_, marks := cv.UnmarkDeep()
+	_, ok := marks[CtySensitiveMark]
+	return ok
+}
+
+// AttrTypeToCtyType returns the cty.Type equivalent of the provided attr.Type
+func AttrTypeToCtyType(typ attr.Type) (cty.Type, error) {
+	return util.TerraformTypeToCtyType(typ.TerraformType(context.Background()))
+}
+
+// CtyTypeToAttrType returns the attr.Type equivalent of the provided cty.Type, e.g. types.TupleType for a cty tuple
+// type.
+func CtyTypeToAttrType(typ cty.Type) (attr.Type, error) {
+	tt, err := util.CtyTypeToTerraformType(typ)
+	if err != nil {
+		return nil, err
+	}
+	return basetypes.TerraformTypeToFrameworkType(tt)
+}
@@ -0,0 +1,93 @@
+package conv_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+func TestCtyValueRoundTrip(t *testing.T) {
+	in := types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+		"name":    types.StringValue("web"),
+		"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Unknown()}),
+		"labels":  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		"enabled": types.BoolNull(),
+	})
+
+	cv := conv.ToCtyValue(in)
+	assert.True(t, cv.Type().IsObjectType())
+	assert.False(t, cv.GetAttr("ports").IsWhollyKnown())
+	assert.True(t, cv.GetAttr("enabled").IsNull())
+	assert.Equal(t, cty.MapValEmpty(cty.String), cv.GetAttr("labels"))
+
+	out, err := conv.TryFromCtyValue(cv, ruleType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, in, out)
+	}
+
+	tuple := types.TupleValueMust(
+		[]attr.Type{types.StringType, types.SetType{ElemType: types.BoolType}},
+		[]attr.Value{types.StringValue("a"), types.SetValueMust(types.BoolType, []attr.Value{types.BoolValue(true)})},
+	)
+	tcv := conv.ToCtyValue(tuple)
+	assert.Equal(t, cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.SetVal([]cty.Value{cty.True})}), tcv)
+	assert.Equal(t, tuple, conv.FromCtyValue(tcv, tuple.Type(context.Background())))
+}
+
+func TestFromCtyValue(t *testing.T) {
+	expr, diags := hclsyntax.ParseExpression([]byte(`{ name = "web", ports = [80, 443], labels = { env = var.env } }`), "test.hcl", hcl.InitialPos)
+	if !assert.False(t, diags.HasErrors(), diags.Error()) {
+		return
+	}
+	ctx := &hcl.EvalContext{Variables: map[string]cty.Value{
+		"var": cty.ObjectVal(map[string]cty.Value{"env": conv.MarkCtySensitive(cty.StringVal("prod"))}),
+	}}
+	cv, diags := expr.Value(ctx)
+	if !assert.False(t, diags.HasErrors(), diags.Error()) {
+		return
+	}
+	assert.True(t, conv.IsCtySensitive(cv))
+
+	out, err := conv.TryFromCtyValue(cv, ruleType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("web"),
+			"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Value(443)}),
+			"labels":  types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+			"enabled": types.BoolNull(),
+		}), out)
+	}
+
+	dv, err := conv.TryFromCtyValue(cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.UnknownVal(cty.Number)}), types.DynamicType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.DynamicValue(types.TupleValueMust(
+			[]attr.Type{types.StringType, types.NumberType},
+			[]attr.Value{types.StringValue("a"), types.NumberUnknown()},
+		)), dv)
+	}
+
+	_, err = conv.TryFromCtyValue(cty.StringVal("a"), types.BoolType)
+	assert.Error(t, err)
+
+	at, err := conv.CtyTypeToAttrType(cty.Object(map[string]cty.Type{"a": cty.List(cty.String)}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.ListType{ElemType: types.StringType}}}, at)
+	}
+	ct, err := conv.AttrTypeToCtyType(ruleType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, cty.Object(map[string]cty.Type{
+			"name":    cty.String,
+			"ports":   cty.List(cty.Number),
+			"labels":  cty.Map(cty.String),
+			"enabled": cty.Bool,
+		}), ct)
+	}
+}
@@ -0,0 +1,42 @@
+package conv
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ConversionErrorDiagnostic returns an attribute error diagnostic at the provided path describing an error returned by
+// one of the Try* or generic conversion funcs
+func ConversionErrorDiagnostic(p path.Path, err error) diag.Diagnostic {
+	var summary string
+	switch {
+	case IsValueIsUnknownError(err):
+		summary = "Unexpected Unknown Value"
+	case IsValueIsNullError(err):
+		summary = "Unexpected Null Value"
+	case IsValueTypeUnhandledError(err):
+		summary = "Unhandled Value Type"
+	case IsValueOverflowError(err):
+		summary = "Value Out Of Range"
+	default:
+		summary = "Value Conversion Error"
+	}
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		summary,
+		fmt.Sprintf("Unable to convert the value at %s: %v", p, err),
+	)
+}
+
+// ConversionDiagnostics returns diagnostics containing a ConversionErrorDiagnostic if err is not nil, e.g.:
+//
+//	enabled, err := conv.TryBoolValueToBool(plan.Enabled)
+//	resp.Diagnostics.Append(conv.ConversionDiagnostics(path.Root("enabled"), err)...)
+func ConversionDiagnostics(p path.Path, err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	return diag.Diagnostics{ConversionErrorDiagnostic(p, err)}
+}
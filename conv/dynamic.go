@@ -0,0 +1,83 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValueToDynamicType ensures we have a types.Dynamic literal, panicking if v is any other type
+func ValueToDynamicType(v attr.Value) types.Dynamic {
+	return must(TryValueToDynamicType(v))
+}
+
+// TryValueToDynamicType ensures we have a types.Dynamic literal, returning an error if v is any other type
+func TryValueToDynamicType(v attr.Value) (types.Dynamic, error) {
+	return valueToBaseType[types.Dynamic]("value_to_dynamic_type", v)
+}
+
+// DynamicToUnderlyingValue returns the concrete value held by a types.Dynamic, panicking on error.  See
+// TryDynamicToUnderlyingValue for details.
+func DynamicToUnderlyingValue(v attr.Value) attr.Value {
+	return must(TryDynamicToUnderlyingValue(v))
+}
+
+// TryDynamicToUnderlyingValue returns the base type literal of the concrete value held by a types.Dynamic, e.g. a
+// types.String.  The concrete value may itself be null or unknown when Terraform has refined its type.
+//
+// An error wrapping ErrValueIsNull or ErrValueIsUnknown is returned if the dynamic value is null or unknown without a
+// concrete type, and one wrapping ErrValueTypeUnhandled if v is not a dynamic value.
+func TryDynamicToUnderlyingValue(v attr.Value) (attr.Value, error) {
+	dv, err := TryValueToDynamicType(v)
+	if err != nil {
+		return nil, err
+	}
+	uv := dv.UnderlyingValue()
+	if uv == nil {
+		if dv.IsUnknown() {
+			return nil, fmt.Errorf("%w: dynamic value has no underlying value", ErrValueIsUnknown)
+		}
+		return nil, fmt.Errorf("%w: dynamic value has no underlying value", ErrValueIsNull)
+	}
+	return TryToBaseValue(uv)
+}
+
+// DynamicToUnderlyingType returns the type of the concrete value held by a types.Dynamic, panicking on error.  See
+// TryDynamicToUnderlyingValue for details.
+func DynamicToUnderlyingType(v attr.Value) attr.Type {
+	return must(TryDynamicToUnderlyingType(v))
+}
+
+// TryDynamicToUnderlyingType is the non-panicking form of DynamicToUnderlyingType
+func TryDynamicToUnderlyingType(v attr.Value) (attr.Type, error) {
+	uv, err := TryDynamicToUnderlyingValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return uv.Type(context.Background()), nil
+}
+
+// FromGoDynamic converts the provided Go value into a types.Dynamic, inferring the type of the underlying value from
+// v.  Slices become tuples, and maps and structs become objects, so that elements of differing types are allowed.
+// nil converts to a null dynamic value.
+func FromGoDynamic[T any](v T) (types.Dynamic, error) {
+	out, err := FromGo(v, types.DynamicType)
+	if err != nil {
+		return types.Dynamic{}, err
+	}
+	return out.(types.Dynamic), nil
+}
+
+// underlyingValueOrSelf returns the base type literal of v, unwrapping types.Dynamic values with a concrete value.  If
+// neither can be determined, v is returned as-is.
+func underlyingValueOrSelf(v attr.Value) attr.Value {
+	v = baseValueOrSelf(v)
+	if _, ok := v.(types.Dynamic); ok {
+		if uv, err := TryDynamicToUnderlyingValue(v); err == nil {
+			return uv
+		}
+	}
+	return v
+}
@@ -0,0 +1,84 @@
+package conv_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+func TestDynamicToUnderlyingValue(t *testing.T) {
+	str := types.StringValue("hi")
+
+	uv, err := conv.TryDynamicToUnderlyingValue(types.DynamicValue(str))
+	if assert.NoError(t, err) {
+		assert.Equal(t, str, uv)
+	}
+	assert.Equal(t, types.StringType, conv.DynamicToUnderlyingType(types.DynamicValue(str)))
+	assert.Equal(t, types.StringNull(), conv.DynamicToUnderlyingValue(types.DynamicValue(types.StringNull())))
+
+	_, err = conv.TryDynamicToUnderlyingValue(types.DynamicNull())
+	assert.True(t, conv.IsValueIsNullError(err))
+	_, err = conv.TryDynamicToUnderlyingValue(types.DynamicUnknown())
+	assert.True(t, conv.IsValueIsUnknownError(err))
+	_, err = conv.TryDynamicToUnderlyingValue(str)
+	assert.True(t, conv.IsValueTypeUnhandledError(err))
+}
+
+func TestFromGoDynamic(t *testing.T) {
+	dv, err := conv.FromGoDynamic("hi")
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.DynamicValue(types.StringValue("hi")), dv)
+	}
+
+	dv, err = conv.FromGoDynamic([]interface{}{"a", 1})
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}}, conv.DynamicToUnderlyingType(dv))
+	}
+
+	dv, err = conv.FromGoDynamic(map[string]interface{}{"name": "fish", "enabled": true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "enabled": types.BoolType}}, conv.DynamicToUnderlyingType(dv))
+	}
+
+	dv, err = conv.FromGoDynamic[*string](nil)
+	if assert.NoError(t, err) {
+		assert.True(t, dv.IsNull())
+	}
+}
+
+func TestDynamicConversions(t *testing.T) {
+	list := types.DynamicValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}))
+
+	assert.Equal(t, 2, conv.AttributeValueLength(list))
+	assert.Equal(t, []string{"a", "b"}, conv.AttributeValueToStrings(list))
+	assert.Equal(t, []string{"a", "b"}, conv.StringListToStrings(list))
+	assert.Equal(t, "hi", conv.AttributeValueToString(types.DynamicValue(types.StringValue("hi"))))
+	assert.Equal(t, int64(5), conv.Int64ValueToInt64(types.DynamicValue(types.Int64Value(5))))
+
+	assert.NoError(t, conv.TestAttributeValueState(list))
+	assert.True(t, conv.IsValueIsEmptyError(conv.TestAttributeValueState(types.DynamicValue(types.StringValue("")))))
+	assert.True(t, conv.IsValueIsNullError(conv.TestAttributeValueState(types.DynamicNull())))
+	assert.True(t, conv.IsValueIsNullError(conv.TestAttributeValueState(types.DynamicValue(types.StringNull()))))
+	assert.True(t, conv.IsValueIsUnknownError(conv.TestAttributeValueState(types.DynamicUnknown())))
+	assert.True(t, conv.IsValueIsUnknownError(conv.TestAttributeValueState(types.DynamicValue(types.ListUnknown(types.StringType)))))
+}
+
+func TestDynamicAttributeValueLength(t *testing.T) {
+	assert.Equal(t, 2, conv.AttributeValueLength(types.DynamicValue(types.StringValue("hi"))))
+	assert.Equal(t, 0, conv.AttributeValueLength(types.DynamicNull()))
+	assert.Equal(t, 0, conv.AttributeValueLength(types.DynamicUnknown()))
+}
+
+func TestSetAttributeValueState(t *testing.T) {
+	set := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")})
+	empty := types.SetValueMust(types.StringType, []attr.Value{})
+
+	assert.NoError(t, conv.TestAttributeValueState(set))
+	assert.NoError(t, conv.TestAttributeValueState(types.DynamicValue(set)))
+	assert.True(t, conv.IsValueIsEmptyError(conv.TestAttributeValueState(empty)))
+	assert.True(t, conv.IsValueIsEmptyError(conv.TestAttributeValueState(types.DynamicValue(empty))))
+}
@@ -13,6 +13,7 @@ var (
 	ErrValueIsUnknown     = errors.New("value is unknown")
 	ErrValueIsEmpty       = errors.New("value is empty")
 	ErrValueTypeUnhandled = errors.New("value type is unhandled, this usually means this package is out of date with the upstream provider framework")
+	ErrValueOverflow      = errors.New("value overflows target type")
 )
 
 func IsValueIsNullError(err error) bool {
@@ -34,3 +35,11 @@ func ValueTypeUnhandledError(scope string, av attr.Value) error {
 func IsValueTypeUnhandledError(err error) bool {
 	return util.MatchError(err, ErrValueTypeUnhandled)
 }
+
+func ValueOverflowError(scope string, v interface{}, target string) error {
+	return fmt.Errorf("%w: scope=%q; value=%v; target=%s", ErrValueOverflow, scope, v, target)
+}
+
+func IsValueOverflowError(err error) bool {
+	return util.MatchError(err, ErrValueOverflow)
+}
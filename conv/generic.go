@@ -0,0 +1,132 @@
+package conv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+// ToGo converts the provided attr.Value into a value of type T.  T may be any combination of primitives, pointers,
+// slices, arrays, string-keyed maps, and structs with tfsdk-tagged fields, along with *big.Float, *big.Int,
+// interface{}, and attr.Value implementations.  Lists, sets, and tuples convert to slices or arrays, and maps and
+// objects convert to maps or structs.
+//
+// Null values convert to nil pointers, slices, and maps, and to the zero value of any other type.  An error wrapping
+// ErrValueIsUnknown is returned if the value, or any value within it, is unknown, unless it is converted to an
+// attr.Value.  Collection and object attr.Value targets nested within other targets take their type from the
+// Terraform value, so that e.g. types.Int64 elements within them become types.Number.  Numbers that cannot be
+// represented by the target type without overflow, or for integer types without truncation, result in an error.
+func ToGo[T any](av attr.Value) (T, error) {
+	var (
+		out    T
+		target = reflect.TypeOf((*T)(nil)).Elem()
+	)
+	if av == nil {
+		return out, fmt.Errorf("%w: cannot convert nil attr.Value to %s", ErrValueIsNull, target)
+	}
+	if target.Kind() != reflect.Interface || target.NumMethod() > 0 {
+		// targets the value already satisfies, e.g. its own type or attr.Value, need no conversion.  interface{}
+		// targets are excluded, as they convert to the Go representation of the value.
+		if tv, ok := av.(T); ok {
+			return tv, nil
+		}
+	}
+	ctx := context.Background()
+	tv, err := av.ToTerraformValue(ctx)
+	if err != nil {
+		return out, err
+	}
+	if err = util.TerraformValueInto(tv, &out); err != nil {
+		if errors.Is(err, util.ErrUnknownValue) {
+			return out, fmt.Errorf("%w: cannot convert %T to %s: %v", ErrValueIsUnknown, av, target, err)
+		}
+		return out, fmt.Errorf("cannot convert %T to %s: %w", av, target, err)
+	}
+	return out, nil
+}
+
+// FromGo converts the provided Go value into an attr.Value of the provided type.  It accepts the same Go types as
+// ToGo, with nil pointers, slices, and maps converting to null.  Map keys not defined by an object type result in an
+// error, and object attributes missing from the map are set to null.
+func FromGo[T any](v T, typ attr.Type) (attr.Value, error) {
+	ctx := context.Background()
+	tv, err := util.GoToTerraformValue(ctx, typ.TerraformType(ctx), v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %T to %s: %w", v, typ, err)
+	}
+	return typ.ValueFromTerraform(ctx, tv)
+}
+
+// lenientToGo converts the provided value as ToGo does, except that unknown values, including those within
+// collections, are treated as null.  This matches the behavior of the older typed conversion funcs it backs.
+func lenientToGo[T any](av attr.Value) (T, error) {
+	var out T
+	tv, err := av.ToTerraformValue(context.Background())
+	if err != nil {
+		return out, err
+	}
+	tv, err = tftypes.Transform(tv, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		return out, err
+	}
+	if err = util.TerraformValueInto(tv, &out); err != nil {
+		return out, fmt.Errorf("cannot convert %T to %T: %w", av, out, err)
+	}
+	return out, nil
+}
+
+// lenientSliceToGo calls lenientToGo, returning an empty rather than nil slice for null and unknown collections
+func lenientSliceToGo[E any](av attr.Value) ([]E, error) {
+	out, err := lenientToGo[[]E](av)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = make([]E, 0)
+	}
+	return out, nil
+}
+
+// mustFromGo calls FromGo, panicking on error.  It backs the older typed conversion funcs.
+func mustFromGo[T any](scope string, v T, typ attr.Type) attr.Value {
+	out, err := FromGo(v, typ)
+	if err != nil {
+		panic(fmt.Sprintf("conv.%s: %v", scope, err))
+	}
+	return out
+}
+
+// must panics if err is not nil, otherwise returning v.  It backs the panicking forms of the Try* funcs.
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+// nonNilSlice ensures nil slices convert to an empty collection rather than null
+func nonNilSlice[T any](in []T) []T {
+	if in == nil {
+		return make([]T, 0)
+	}
+	return in
+}
+
+// nonNilMap ensures nil maps convert to an empty collection rather than null
+func nonNilMap[V any](in map[string]V) map[string]V {
+	if in == nil {
+		return make(map[string]V)
+	}
+	return in
+}
@@ -0,0 +1,145 @@
+package conv_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+func TestToGo(t *testing.T) {
+	s, err := conv.ToGo[string](types.StringValue("hello"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", s)
+
+	sp, err := conv.ToGo[*string](types.StringNull())
+	assert.NoError(t, err)
+	assert.Nil(t, sp)
+
+	bs, err := conv.ToGo[[]bool](types.SetValueMust(types.BoolType, []attr.Value{types.BoolValue(true)}))
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, bs)
+
+	fs, err := conv.ToGo[[]*float64](types.ListValueMust(types.Float64Type, []attr.Value{types.Float64Value(1.5), types.Float64Null()}))
+	assert.NoError(t, err)
+	if assert.Len(t, fs, 2) {
+		assert.Equal(t, 1.5, *fs[0])
+		assert.Nil(t, fs[1])
+	}
+
+	nested, err := conv.ToGo[map[string][]int64](types.MapValueMust(
+		types.ListType{ElemType: types.Int64Type},
+		map[string]attr.Value{
+			"a": types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
+		},
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]int64{"a": {1, 2}}, nested)
+
+	bf, err := conv.ToGo[*big.Float](types.NumberValue(big.NewFloat(2.5)))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, bf.Cmp(big.NewFloat(2.5)))
+
+	iface, err := conv.ToGo[interface{}](types.ObjectValueMust(
+		map[string]attr.Type{"name": types.StringType, "ok": types.BoolType},
+		map[string]attr.Value{"name": types.StringValue("fish"), "ok": types.BoolValue(true)},
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "fish", "ok": true}, iface)
+
+	_, err = conv.ToGo[string](types.StringUnknown())
+	assert.True(t, conv.IsValueIsUnknownError(err), "Expected unknown error, saw %v", err)
+
+	_, err = conv.ToGo[int8](types.Int64Value(math.MaxInt16))
+	assert.Error(t, err)
+
+	_, err = conv.ToGo[int](types.NumberValue(big.NewFloat(1.5)))
+	assert.Error(t, err)
+
+	_, err = conv.ToGo[bool](types.StringValue("true"))
+	assert.Error(t, err)
+}
+
+func TestToGoAttrValue(t *testing.T) {
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown()})
+	lv, err := conv.ToGo[types.List](list)
+	if assert.NoError(t, err) {
+		assert.Equal(t, list, lv)
+	}
+
+	obj := types.ObjectValueMust(
+		map[string]attr.Type{"tags": types.SetType{ElemType: types.StringType}, "size": types.Int64Type},
+		map[string]attr.Value{"tags": types.SetNull(types.StringType), "size": types.Int64Value(3)},
+	)
+	ov, err := conv.ToGo[types.Object](obj)
+	if assert.NoError(t, err) {
+		assert.Equal(t, obj, ov)
+	}
+
+	sv, err := conv.ToGo[types.String](types.StringUnknown())
+	if assert.NoError(t, err) {
+		assert.True(t, sv.IsUnknown())
+	}
+
+	av, err := conv.ToGo[attr.Value](types.StringValue("x"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.StringValue("x"), av)
+	}
+
+	_, err = conv.ToGo[fmt.Stringer](types.StringValue("x"))
+	assert.NoError(t, err)
+
+	_, err = conv.ToGo[error](types.StringValue("x"))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "to error")
+	}
+}
+
+func TestFromGo(t *testing.T) {
+	v, err := conv.FromGo("hello", types.StringType)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue("hello"), v)
+
+	v, err = conv.FromGo[*int](nil, types.Int64Type)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Int64Null(), v)
+
+	v, err = conv.FromGo([]float32{1, 2}, types.ListType{ElemType: types.Float64Type})
+	assert.NoError(t, err)
+	assert.Equal(t, types.ListValueMust(types.Float64Type, []attr.Value{types.Float64Value(1), types.Float64Value(2)}), v)
+
+	v, err = conv.FromGo(map[string][]string{"a": {"b"}}, types.MapType{ElemType: types.SetType{ElemType: types.StringType}})
+	assert.NoError(t, err)
+	assert.Equal(t, types.MapValueMust(
+		types.SetType{ElemType: types.StringType},
+		map[string]attr.Value{"a": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("b")})},
+	), v)
+
+	v, err = conv.FromGo([]string(nil), types.ListType{ElemType: types.StringType})
+	assert.NoError(t, err)
+	assert.Equal(t, types.ListNull(types.StringType), v)
+
+	_, err = conv.FromGo(5, types.StringType)
+	assert.Error(t, err)
+}
+
+func TestTypedWrappers(t *testing.T) {
+	l := conv.StringsToStringList([]string{"a", "b"}, false)
+	assert.Equal(t, []string{"a", "b"}, conv.StringListToStrings(l))
+
+	s := conv.IntsToInt64Set([]int{1, 2}, false)
+	assert.ElementsMatch(t, []int{1, 2}, conv.Int64SetToInts(s))
+
+	assert.True(t, conv.StringsToStringSet(nil, true).IsNull())
+	assert.False(t, conv.IntsToInt64List(nil, false).IsNull())
+
+	unknown := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown()})
+	assert.Equal(t, []string{"a", ""}, conv.StringListToStrings(unknown))
+	assert.Empty(t, conv.StringListToStrings(types.ListUnknown(types.StringType)))
+}
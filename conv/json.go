@@ -0,0 +1,76 @@
+package conv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+// JSONOpts modifies the behavior of MarshalJSONWithOpts and UnmarshalJSONWithOpts
+type JSONOpts struct {
+	// Typed wraps the encoded value in an object containing its tftypes "type" alongside its "value", the same form
+	// used for dynamically typed values.  When unmarshalling, the encoded type is used in place of the provided one,
+	// which must still be able to hold it.
+	Typed bool
+}
+
+// MarshalJSON encodes the provided attr.Value as JSON.  See MarshalJSONWithOpts for details.
+func MarshalJSON(v attr.Value) ([]byte, error) {
+	return MarshalJSONWithOpts(v, JSONOpts{})
+}
+
+// MarshalJSONWithOpts encodes the provided attr.Value as JSON.  Null values, including those within collections, are
+// encoded as null, and numbers are encoded without loss of precision.  Values of types.Dynamic, including those within
+// collections and objects, are encoded as an object containing their tftypes "type" and "value" so that they may be
+// decoded by UnmarshalJSON.
+//
+// An error wrapping ErrValueIsUnknown is returned if the value, or any value within it, is unknown.
+func MarshalJSONWithOpts(v attr.Value, opts JSONOpts) ([]byte, error) {
+	tv, err := TryToTerraformValue(v)
+	if err != nil {
+		return nil, err
+	}
+	typ := tftypes.Type(tftypes.DynamicPseudoType)
+	if !opts.Typed {
+		typ = v.Type(context.Background()).TerraformType(context.Background())
+	}
+	b, err := util.TerraformValueToJSON(tv, typ)
+	if err != nil {
+		if errors.Is(err, util.ErrUnknownValue) {
+			return nil, fmt.Errorf("%w: cannot encode %T as JSON: %v", ErrValueIsUnknown, v, err)
+		}
+		return nil, fmt.Errorf("cannot encode %T as JSON: %w", v, err)
+	}
+	return b, nil
+}
+
+// UnmarshalJSON decodes the provided JSON into an attr.Value of the provided type.  See UnmarshalJSONWithOpts for
+// details.
+func UnmarshalJSON(data []byte, typ attr.Type) (attr.Value, error) {
+	return UnmarshalJSONWithOpts(data, typ, JSONOpts{})
+}
+
+// UnmarshalJSONWithOpts decodes the provided JSON into an attr.Value of the provided type, such as a types.ObjectType
+// describing a JSON payload.  JSON null decodes to a null value, numbers are decoded into a *big.Float without loss of
+// precision, and object attributes missing from the JSON are set to null.  Values of types.DynamicType must be encoded
+// as MarshalJSON encodes them.
+func UnmarshalJSONWithOpts(data []byte, typ attr.Type, opts JSONOpts) (attr.Value, error) {
+	tt := typ.TerraformType(context.Background())
+	if opts.Typed {
+		tt = tftypes.DynamicPseudoType
+	}
+	tv, err := tftypes.ValueFromJSON(data, tt)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode JSON as %s: %w", typ, err)
+	}
+	if tv.IsNull() && tv.Type().Is(tftypes.DynamicPseudoType) {
+		// a typed null carries no type information of its own
+		tv = tftypes.NewValue(typ.TerraformType(context.Background()), nil)
+	}
+	return TryFromTerraformValue(tv, typ)
+}
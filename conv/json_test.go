@@ -0,0 +1,88 @@
+package conv_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	in := types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+		"name":    types.StringValue("web \"primary\""),
+		"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Value(9007199254740993)}),
+		"labels":  types.MapValueMust(types.StringType, map[string]attr.Value{"b": types.StringNull(), "a": types.StringValue("1")}),
+		"enabled": types.BoolNull(),
+	})
+
+	b, err := conv.MarshalJSON(in)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"enabled":null,"labels":{"a":"1","b":null},"name":"web \"primary\"","ports":[80,9007199254740993]}`, string(b))
+		assert.Contains(t, string(b), "9007199254740993")
+
+		out, err := conv.UnmarshalJSON(b, ruleType)
+		if assert.NoError(t, err) {
+			assert.Equal(t, in, out)
+		}
+	}
+
+	b, err = conv.MarshalJSONWithOpts(types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)}), conv.JSONOpts{Typed: true})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"type":["list","bool"],"value":[true]}`, string(b))
+
+		out, err := conv.UnmarshalJSONWithOpts(b, types.DynamicType, conv.JSONOpts{Typed: true})
+		if assert.NoError(t, err) {
+			assert.Equal(t, types.DynamicValue(types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)})), out)
+		}
+	}
+
+	dyn := types.DynamicValue(types.TupleValueMust([]attr.Type{types.StringType, types.NumberType}, []attr.Value{types.StringValue("a"), types.NumberValue(big.NewFloat(1.5))}))
+	b, err = conv.MarshalJSON(dyn)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"type":["tuple",["string","number"]],"value":["a",1.5]}`, string(b))
+		out, err := conv.UnmarshalJSON(b, types.DynamicType)
+		if assert.NoError(t, err) {
+			assert.True(t, dyn.Equal(out))
+		}
+	}
+
+	_, err = conv.MarshalJSON(types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}))
+	assert.True(t, conv.IsValueIsUnknownError(err))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	out, err := conv.UnmarshalJSON([]byte(`{"name":"web","ports":[80,443]}`), ruleType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("web"),
+			"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Value(443)}),
+			"labels":  types.MapNull(types.StringType),
+			"enabled": types.BoolNull(),
+		}), out)
+	}
+
+	out, err = conv.UnmarshalJSON([]byte(`0.1000000000000000000000000001`), types.NumberType)
+	if assert.NoError(t, err) {
+		expected, _, _ := big.ParseFloat("0.1000000000000000000000000001", 10, 512, big.ToNearestEven)
+		assert.Equal(t, 0, expected.Cmp(conv.NumberValueToBigFloat(out)))
+	}
+
+	out, err = conv.UnmarshalJSON([]byte(`null`), types.ListType{ElemType: types.StringType})
+	if assert.NoError(t, err) {
+		assert.True(t, out.IsNull())
+	}
+
+	out, err = conv.UnmarshalJSONWithOpts([]byte(`null`), ruleType, conv.JSONOpts{Typed: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ObjectNull(ruleType.AttrTypes), out)
+	}
+
+	_, err = conv.UnmarshalJSON([]byte(`{"ports":"x"}`), ruleType)
+	assert.Error(t, err)
+	_, err = conv.UnmarshalJSON([]byte(`{"other":"x"}`), ruleType)
+	assert.Error(t, err)
+}
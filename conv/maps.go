@@ -0,0 +1,173 @@
+package conv
+
+import (
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+// StringMapToStringsMap accepts an instance of either types.Map or *types.Map where ElementType MUST be
+// types.StringType, returning a map of the value of each element.
+func StringMapToStringsMap(v attr.Value) map[string]string {
+	return must(TryStringMapToStringsMap(v))
+}
+
+// TryStringMapToStringsMap is the non-panicking form of StringMapToStringsMap
+func TryStringMapToStringsMap(v attr.Value) (map[string]string, error) {
+	vt, err := TryValueToMapType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientToGo[map[string]string](vt)
+}
+
+// Int64MapToIntsMap accepts an instance of either types.Map or *types.Map where ElementType MUST be types.Int64Type,
+// returning a map of the int value of each element.
+func Int64MapToIntsMap(v attr.Value) map[string]int {
+	return must(TryInt64MapToIntsMap(v))
+}
+
+// TryInt64MapToIntsMap is the non-panicking form of Int64MapToIntsMap
+func TryInt64MapToIntsMap(v attr.Value) (map[string]int, error) {
+	vt, err := TryValueToMapType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientToGo[map[string]int](vt)
+}
+
+// BoolMapToBoolsMap accepts an instance of either types.Map or *types.Map where ElementType MUST be types.BoolType,
+// returning a map of the value of each element.
+func BoolMapToBoolsMap(v attr.Value) map[string]bool {
+	return must(TryBoolMapToBoolsMap(v))
+}
+
+// TryBoolMapToBoolsMap is the non-panicking form of BoolMapToBoolsMap
+func TryBoolMapToBoolsMap(v attr.Value) (map[string]bool, error) {
+	vt, err := TryValueToMapType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientToGo[map[string]bool](vt)
+}
+
+// StringListMapToStringSlicesMap accepts an instance of either types.Map or *types.Map where ElementType MUST be a
+// types.ListType with an ElemType of types.StringType, returning a map of the strings within each element.
+func StringListMapToStringSlicesMap(v attr.Value) map[string][]string {
+	return must(TryStringListMapToStringSlicesMap(v))
+}
+
+// TryStringListMapToStringSlicesMap is the non-panicking form of StringListMapToStringSlicesMap
+func TryStringListMapToStringSlicesMap(v attr.Value) (map[string][]string, error) {
+	vt, err := TryValueToMapType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientToGo[map[string][]string](vt)
+}
+
+// StringsMapToStringMap takes a map of strings and creates a typed types.Map with an ElementType of types.String and
+// each value of Elements being an instance of types.String
+//
+// If nullOnEmpty parameter is `true`, the returned types.Map will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func StringsMapToStringMap(in map[string]string, nullOnEmpty bool) types.Map {
+	if nullOnEmpty && len(in) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	return mustFromGo("StringsMapToStringMap", nonNilMap(in), types.MapType{ElemType: types.StringType}).(types.Map)
+}
+
+// IntsMapToInt64Map takes a map of ints and creates a typed types.Map with an ElementType of types.Int64Type and each
+// value of Elements being an instance of types.Int64
+//
+// If nullOnEmpty parameter is `true`, the returned types.Map will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func IntsMapToInt64Map(in map[string]int, nullOnEmpty bool) types.Map {
+	if nullOnEmpty && len(in) == 0 {
+		return types.MapNull(types.Int64Type)
+	}
+
+	return mustFromGo("IntsMapToInt64Map", nonNilMap(in), types.MapType{ElemType: types.Int64Type}).(types.Map)
+}
+
+// BoolsMapToBoolMap takes a map of bools and creates a typed types.Map with an ElementType of types.BoolType and each
+// value of Elements being an instance of types.Bool
+//
+// If nullOnEmpty parameter is `true`, the returned types.Map will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func BoolsMapToBoolMap(in map[string]bool, nullOnEmpty bool) types.Map {
+	if nullOnEmpty && len(in) == 0 {
+		return types.MapNull(types.BoolType)
+	}
+
+	return mustFromGo("BoolsMapToBoolMap", nonNilMap(in), types.MapType{ElemType: types.BoolType}).(types.Map)
+}
+
+// StringSlicesMapToStringListMap takes a map of string slices and creates a typed types.Map with an ElementType of
+// types.ListType{ElemType: types.StringType}.  nil slices within the map become empty lists.
+//
+// If nullOnEmpty parameter is `true`, the returned types.Map will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func StringSlicesMapToStringListMap(in map[string][]string, nullOnEmpty bool) types.Map {
+	elemType := types.ListType{ElemType: types.StringType}
+	if nullOnEmpty && len(in) == 0 {
+		return types.MapNull(elemType)
+	}
+
+	out := make(map[string][]string, len(in))
+	for k, v := range in {
+		out[k] = nonNilSlice(v)
+	}
+	return mustFromGo("StringSlicesMapToStringListMap", out, types.MapType{ElemType: elemType}).(types.Map)
+}
+
+// SortedKeys returns the keys of the provided map in ascending order
+func SortedKeys[V any](m map[string]V) []string {
+	return util.SortedKeys(m)
+}
+
+// MapValueKeys accepts an instance of either types.Map or *types.Map, returning its keys in ascending order
+func MapValueKeys(v attr.Value) []string {
+	return must(TryMapValueKeys(v))
+}
+
+// TryMapValueKeys is the non-panicking form of MapValueKeys
+func TryMapValueKeys(v attr.Value) ([]string, error) {
+	vt, err := TryValueToMapType(v)
+	if err != nil {
+		return nil, err
+	}
+	return SortedKeys(vt.Elements()), nil
+}
+
+// SortedMapElements accepts an instance of either types.Map or *types.Map, returning an iterator over its elements in
+// ascending key order, e.g.:
+//
+//	for k, v := range conv.SortedMapElements(plan.Tags) {
+//		...
+//	}
+func SortedMapElements(v attr.Value) iter.Seq2[string, attr.Value] {
+	return must(TrySortedMapElements(v))
+}
+
+// TrySortedMapElements is the non-panicking form of SortedMapElements
+func TrySortedMapElements(v attr.Value) (iter.Seq2[string, attr.Value], error) {
+	vt, err := TryValueToMapType(v)
+	if err != nil {
+		return nil, err
+	}
+	elems := vt.Elements()
+	keys := SortedKeys(elems)
+	return func(yield func(string, attr.Value) bool) {
+		for _, k := range keys {
+			if !yield(k, elems[k]) {
+				return
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,58 @@
+package conv_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+func TestMapConversions(t *testing.T) {
+	tags := map[string]string{"b": "2", "a": "1"}
+	tv := conv.StringsMapToStringMap(tags, false)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"a": types.StringValue("1"),
+		"b": types.StringValue("2"),
+	}), tv)
+	assert.Equal(t, tags, conv.StringMapToStringsMap(tv))
+
+	ints := map[string]int{"x": 1}
+	assert.Equal(t, ints, conv.Int64MapToIntsMap(conv.IntsMapToInt64Map(ints, false)))
+
+	bools := map[string]bool{"x": true, "y": false}
+	assert.Equal(t, bools, conv.BoolMapToBoolsMap(conv.BoolsMapToBoolMap(bools, false)))
+
+	lists := map[string][]string{"x": {"a", "b"}, "y": nil}
+	lv := conv.StringSlicesMapToStringListMap(lists, false)
+	assert.Equal(t, map[string][]string{"x": {"a", "b"}, "y": {}}, conv.StringListMapToStringSlicesMap(lv))
+
+	assert.True(t, conv.StringsMapToStringMap(nil, true).IsNull())
+	assert.True(t, conv.IntsMapToInt64Map(map[string]int{}, true).IsNull())
+	assert.False(t, conv.BoolsMapToBoolMap(nil, false).IsNull())
+	assert.Equal(t, 0, conv.AttributeValueLength(conv.StringSlicesMapToStringListMap(nil, false)))
+	assert.Nil(t, conv.StringMapToStringsMap(types.MapNull(types.StringType)))
+
+	_, err := conv.TryStringMapToStringsMap(types.ListNull(types.StringType))
+	assert.True(t, conv.IsValueTypeUnhandledError(err))
+}
+
+func TestSortedMapElements(t *testing.T) {
+	tv := conv.StringsMapToStringMap(map[string]string{"c": "3", "a": "1", "b": "2"}, false)
+
+	assert.Equal(t, []string{"a", "b", "c"}, conv.MapValueKeys(tv))
+	assert.Equal(t, []string{"x", "y"}, conv.SortedKeys(map[string]int{"y": 1, "x": 2}))
+
+	var keys, values []string
+	for k, v := range conv.SortedMapElements(tv) {
+		keys = append(keys, k)
+		values = append(values, conv.AttributeValueToString(v))
+		if k == "b" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, []string{"1", "2"}, values)
+}
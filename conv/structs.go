@@ -0,0 +1,68 @@
+package conv
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/internal/util"
+)
+
+// AttrTypeOf derives the attr.Type values of type T convert to with FromGo.  Pointers are dereferenced, slices and
+// arrays become lists, string-keyed maps become maps, and structs become objects with an attribute per field tagged
+// with `tfsdk:"name"`.  Fields which are attr.Value implementations, e.g. types.String, use the value's own type.
+// Collection and object attr.Value fields, e.g. types.List, only know their type from a value and result in an error.
+func AttrTypeOf[T any]() (attr.Type, error) {
+	return util.AttrTypeOf(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// ObjectAttrTypesOf returns the attribute types of the object the struct type T converts to, suitable for use with
+// types.ObjectNull and friends
+func ObjectAttrTypesOf[T any]() (map[string]attr.Type, error) {
+	typ, err := AttrTypeOf[T]()
+	if err != nil {
+		return nil, err
+	}
+	ot, ok := typ.(types.ObjectType)
+	if !ok {
+		var zero T
+		return nil, fmt.Errorf("%T does not convert to an object", zero)
+	}
+	return ot.AttrTypes, nil
+}
+
+// StructToObject converts a plain Go struct, or pointer to one, into a types.Object.  The object's type is derived
+// with AttrTypeOf, except that attr.Value fields such as types.List use the type of the value they hold, including
+// within nested structs and the first element of slices and maps.  Empty or nil slices and maps of structs with such
+// fields have no value to take the type from, and result in an error.  Nil pointers, either the struct itself or any
+// of its fields, convert to null.
+//
+//	type Rule struct {
+//		Name    string   `tfsdk:"name"`
+//		Port    *int     `tfsdk:"port"`
+//		Sources []string `tfsdk:"sources"`
+//	}
+func StructToObject[T any](v T) (types.Object, error) {
+	typ, err := util.AttrTypeOfValue(reflect.ValueOf(&v).Elem())
+	if err != nil {
+		return types.ObjectNull(nil), err
+	}
+	ot, ok := typ.(types.ObjectType)
+	if !ok {
+		return types.ObjectNull(nil), fmt.Errorf("%T does not convert to an object", v)
+	}
+	attrTypes := ot.AttrTypes
+	av, err := FromGo(v, types.ObjectType{AttrTypes: attrTypes})
+	if err != nil {
+		return types.ObjectNull(attrTypes), err
+	}
+	return av.(types.Object), nil
+}
+
+// ObjectToStruct converts a types.Object, or any other object value, into a plain Go struct of type T.  A null
+// object converts to the zero value of T, or nil if T is a pointer.
+func ObjectToStruct[T any](v attr.Value) (T, error) {
+	return ToGo[T](v)
+}
@@ -0,0 +1,152 @@
+package conv_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+type testTarget struct {
+	Host string `tfsdk:"host"`
+	Port *int   `tfsdk:"port"`
+}
+
+type testRule struct {
+	Name    string            `tfsdk:"name"`
+	Enabled bool              `tfsdk:"enabled"`
+	Weight  *float64          `tfsdk:"weight"`
+	Tags    map[string]string `tfsdk:"tags"`
+	Targets []testTarget      `tfsdk:"targets"`
+	Primary *testTarget       `tfsdk:"primary"`
+	Comment types.String      `tfsdk:"comment"`
+	ignored string
+}
+
+func TestAttrTypeOf(t *testing.T) {
+	targetType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"host": types.StringType,
+		"port": types.Int64Type,
+	}}
+
+	attrTypes, err := conv.ObjectAttrTypesOf[testRule]()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]attr.Type{
+		"name":    types.StringType,
+		"enabled": types.BoolType,
+		"weight":  types.Float64Type,
+		"tags":    types.MapType{ElemType: types.StringType},
+		"targets": types.ListType{ElemType: targetType},
+		"primary": targetType,
+		"comment": types.StringType,
+	}, attrTypes)
+
+	_, err = conv.ObjectAttrTypesOf[[]string]()
+	assert.Error(t, err)
+}
+
+func TestStructToObject(t *testing.T) {
+	port := 443
+	in := testRule{
+		Name:    "web",
+		Enabled: true,
+		Tags:    map[string]string{"env": "test"},
+		Targets: []testTarget{{Host: "a", Port: &port}, {Host: "b"}},
+		Comment: types.StringUnknown(),
+		ignored: "ignored",
+	}
+
+	obj, err := conv.StructToObject(in)
+	if !assert.NoError(t, err) {
+		return
+	}
+	attrs := obj.Attributes()
+	assert.Equal(t, types.StringValue("web"), attrs["name"])
+	assert.True(t, attrs["weight"].IsNull())
+	assert.True(t, attrs["primary"].IsNull())
+	assert.True(t, attrs["comment"].IsUnknown())
+	assert.Equal(t, 2, len(attrs["targets"].(types.List).Elements()))
+
+	out, err := conv.ObjectToStruct[testRule](obj)
+	assert.NoError(t, err)
+	in.ignored = ""
+	assert.Equal(t, in, out)
+
+	null, err := conv.StructToObject[*testRule](nil)
+	assert.NoError(t, err)
+	assert.True(t, null.IsNull())
+
+	ptr, err := conv.ObjectToStruct[*testRule](null)
+	assert.NoError(t, err)
+	assert.Nil(t, ptr)
+
+	_, err = conv.ObjectToStruct[testTarget](obj)
+	assert.Error(t, err, "Expected error converting object with attributes missing from struct")
+}
+
+type testCollectionRule struct {
+	Name  string     `tfsdk:"name"`
+	Ports types.List `tfsdk:"ports"`
+}
+
+func TestStructToObjectCollectionField(t *testing.T) {
+	_, err := conv.AttrTypeOf[testCollectionRule]()
+	assert.Error(t, err, "Expected error deriving type of types.List field without a value")
+
+	in := testCollectionRule{
+		Name:  "web",
+		Ports: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("80"), types.StringValue("443")}),
+	}
+	obj, err := conv.StructToObject(in)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, types.ListType{ElemType: types.StringType}, obj.AttributeTypes(context.Background())["ports"])
+	assert.Equal(t, in.Ports, obj.Attributes()["ports"])
+
+	out, err := conv.ObjectToStruct[testCollectionRule](obj)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+
+	_, err = conv.StructToObject(testCollectionRule{Name: "web"})
+	assert.Error(t, err, "Expected error converting struct with zero types.List field")
+}
+
+type testCollectionRules struct {
+	Items  []testCollectionRule          `tfsdk:"items"`
+	ByName map[string]testCollectionRule `tfsdk:"by_name"`
+}
+
+func TestStructToObjectNestedCollectionField(t *testing.T) {
+	rule := testCollectionRule{
+		Name:  "web",
+		Ports: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("80")}),
+	}
+	in := testCollectionRules{
+		Items:  []testCollectionRule{rule},
+		ByName: map[string]testCollectionRule{"web": rule},
+	}
+	obj, err := conv.StructToObject(in)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ruleType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"ports": types.ListType{ElemType: types.StringType},
+	}}
+	assert.Equal(t, map[string]attr.Type{
+		"items":   types.ListType{ElemType: ruleType},
+		"by_name": types.MapType{ElemType: ruleType},
+	}, obj.AttributeTypes(context.Background()))
+
+	out, err := conv.ObjectToStruct[testCollectionRules](obj)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+
+	_, err = conv.StructToObject(testCollectionRules{ByName: in.ByName})
+	assert.Error(t, err, "Expected error converting nil slice of structs with types.List fields")
+}
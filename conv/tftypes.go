@@ -0,0 +1,72 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ToTerraformValue converts the provided attr.Value into a tftypes.Value, panicking on error.  See TryToTerraformValue
+// for details.
+func ToTerraformValue(v attr.Value) tftypes.Value {
+	return must(TryToTerraformValue(v))
+}
+
+// TryToTerraformValue converts the provided attr.Value into a tftypes.Value of its type's Terraform type.  Null and
+// unknown values are preserved at every level of nesting.
+func TryToTerraformValue(v attr.Value) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.Value{}, fmt.Errorf("%w: cannot convert nil attr.Value to tftypes.Value", ErrValueIsNull)
+	}
+	return v.ToTerraformValue(context.Background())
+}
+
+// FromTerraformValue converts the provided tftypes.Value into an attr.Value of the provided type, panicking on error.
+// See TryFromTerraformValue for details.
+func FromTerraformValue(tv tftypes.Value, typ attr.Type) attr.Value {
+	return must(TryFromTerraformValue(tv, typ))
+}
+
+// TryFromTerraformValue converts the provided tftypes.Value into an attr.Value of the provided type.  Null and unknown
+// values are preserved at every level of nesting.
+//
+// An error is returned if the Terraform type of tv cannot be used as the Terraform type of typ.
+func TryFromTerraformValue(tv tftypes.Value, typ attr.Type) (attr.Value, error) {
+	ctx := context.Background()
+	tt := typ.TerraformType(ctx)
+	if tv.Type() == nil {
+		return nil, fmt.Errorf("cannot convert tftypes.Value without a type to %s", typ)
+	}
+	if !tv.Type().UsableAs(tt) {
+		return nil, fmt.Errorf("cannot convert tftypes.Value of type %s to %s", tv.Type(), typ)
+	}
+	return typ.ValueFromTerraform(ctx, tv)
+}
+
+// FromRawState decodes the provided raw state, such as that found on a resource.UpgradeStateRequest, into an
+// attr.Value of the provided type, panicking on error.  See TryFromRawState for details.
+func FromRawState(rs *tfprotov6.RawState, typ attr.Type) attr.Value {
+	return must(TryFromRawState(rs, typ))
+}
+
+// TryFromRawState decodes the provided raw state, such as that found on a resource.UpgradeStateRequest, into an
+// attr.Value of the provided type, usually the types.ObjectType of the prior schema version.  Attributes present in
+// the state but not defined by typ are ignored, allowing attributes removed from the schema to be dropped.
+//
+// The result may then be converted into a struct with ObjectToStruct.
+func TryFromRawState(rs *tfprotov6.RawState, typ attr.Type) (attr.Value, error) {
+	if rs == nil {
+		return nil, fmt.Errorf("%w: raw state is nil", ErrValueIsNull)
+	}
+	tv, err := rs.UnmarshalWithOpts(
+		typ.TerraformType(context.Background()),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode raw state as %s: %w", typ, err)
+	}
+	return TryFromTerraformValue(tv, typ)
+}
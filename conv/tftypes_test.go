@@ -0,0 +1,65 @@
+package conv_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+var ruleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":    types.StringType,
+	"ports":   types.ListType{ElemType: types.Int64Type},
+	"labels":  types.MapType{ElemType: types.StringType},
+	"enabled": types.BoolType,
+}}
+
+func TestTerraformValueRoundTrip(t *testing.T) {
+	in := types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+		"name":    types.StringValue("web"),
+		"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Unknown()}),
+		"labels":  types.MapNull(types.StringType),
+		"enabled": types.BoolUnknown(),
+	})
+
+	tv := conv.ToTerraformValue(in)
+	assert.True(t, tv.Type().Is(ruleType.TerraformType(context.Background())))
+
+	out, err := conv.TryFromTerraformValue(tv, ruleType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, in, out)
+	}
+
+	_, err = conv.TryFromTerraformValue(tftypes.NewValue(tftypes.String, "web"), ruleType)
+	assert.Error(t, err)
+
+	dv, err := conv.TryFromTerraformValue(tftypes.NewValue(tftypes.String, "web"), types.DynamicType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.DynamicValue(types.StringValue("web")), dv)
+	}
+}
+
+func TestFromRawState(t *testing.T) {
+	rs := &tfprotov6.RawState{
+		JSON: []byte(`{"name":"web","ports":[80,443],"labels":null,"enabled":true,"removed":"gone"}`),
+	}
+
+	out, err := conv.TryFromRawState(rs, ruleType)
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("web"),
+			"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Value(443)}),
+			"labels":  types.MapNull(types.StringType),
+			"enabled": types.BoolValue(true),
+		}), out)
+	}
+
+	_, err = conv.TryFromRawState(nil, ruleType)
+	assert.True(t, conv.IsValueIsNullError(err))
+}
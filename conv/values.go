@@ -2,6 +2,7 @@ package conv
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"strconv"
 
@@ -9,103 +10,124 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// ValueToBoolType ensures we have a types.Bool literal
+// ValueToBoolType ensures we have a types.Bool literal, panicking if v is any other type
 func ValueToBoolType(v attr.Value) types.Bool {
-	if vb, ok := v.(types.Bool); ok {
-		return vb
-	} else if vb, ok := v.(*types.Bool); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToBoolType", v))
-	}
+	return must(TryValueToBoolType(v))
+}
+
+// TryValueToBoolType ensures we have a types.Bool literal, returning an error if v is any other type
+func TryValueToBoolType(v attr.Value) (types.Bool, error) {
+	return valueToBaseType[types.Bool]("value_to_bool_type", v)
+}
+
+// ValueToFloat32Type ensures we have a types.Float32 literal, panicking if v is any other type
+func ValueToFloat32Type(v attr.Value) types.Float32 {
+	return must(TryValueToFloat32Type(v))
+}
+
+// TryValueToFloat32Type ensures we have a types.Float32 literal, returning an error if v is any other type
+func TryValueToFloat32Type(v attr.Value) (types.Float32, error) {
+	return valueToBaseType[types.Float32]("value_to_float32_type", v)
 }
 
-// ValueToFloat64Type ensures we have a types.Float64 literal
+// ValueToFloat64Type ensures we have a types.Float64 literal, panicking if v is any other type
 func ValueToFloat64Type(v attr.Value) types.Float64 {
-	if vb, ok := v.(types.Float64); ok {
-		return vb
-	} else if vb, ok := v.(*types.Float64); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToFloat64Type", v))
-	}
+	return must(TryValueToFloat64Type(v))
 }
 
-// ValueToInt64Type ensures we have a types.Int64 literal
+// TryValueToFloat64Type ensures we have a types.Float64 literal, returning an error if v is any other type
+func TryValueToFloat64Type(v attr.Value) (types.Float64, error) {
+	return valueToBaseType[types.Float64]("value_to_float64_type", v)
+}
+
+// ValueToInt32Type ensures we have a types.Int32 literal, panicking if v is any other type
+func ValueToInt32Type(v attr.Value) types.Int32 {
+	return must(TryValueToInt32Type(v))
+}
+
+// TryValueToInt32Type ensures we have a types.Int32 literal, returning an error if v is any other type
+func TryValueToInt32Type(v attr.Value) (types.Int32, error) {
+	return valueToBaseType[types.Int32]("value_to_int32_type", v)
+}
+
+// ValueToInt64Type ensures we have a types.Int64 literal, panicking if v is any other type
 func ValueToInt64Type(v attr.Value) types.Int64 {
-	if vb, ok := v.(types.Int64); ok {
-		return vb
-	} else if vb, ok := v.(*types.Int64); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToInt64Type", v))
-	}
+	return must(TryValueToInt64Type(v))
 }
 
-// ValueToListType ensures we have a types.List literal
+// TryValueToInt64Type ensures we have a types.Int64 literal, returning an error if v is any other type
+func TryValueToInt64Type(v attr.Value) (types.Int64, error) {
+	return valueToBaseType[types.Int64]("value_to_int64_type", v)
+}
+
+// ValueToListType ensures we have a types.List literal, panicking if v is any other type
 func ValueToListType(v attr.Value) types.List {
-	if vb, ok := v.(types.List); ok {
-		return vb
-	} else if vb, ok := v.(*types.List); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToListType", v))
-	}
+	return must(TryValueToListType(v))
+}
+
+// TryValueToListType ensures we have a types.List literal, returning an error if v is any other type
+func TryValueToListType(v attr.Value) (types.List, error) {
+	return valueToBaseType[types.List]("value_to_list_type", v)
 }
 
-// ValueToMapType ensures we have a types.Map literal
+// ValueToMapType ensures we have a types.Map literal, panicking if v is any other type
 func ValueToMapType(v attr.Value) types.Map {
-	if vb, ok := v.(types.Map); ok {
-		return vb
-	} else if vb, ok := v.(*types.Map); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToMapType", v))
-	}
+	return must(TryValueToMapType(v))
+}
+
+// TryValueToMapType ensures we have a types.Map literal, returning an error if v is any other type
+func TryValueToMapType(v attr.Value) (types.Map, error) {
+	return valueToBaseType[types.Map]("value_to_map_type", v)
 }
 
-// ValueToNumberType ensures we have a types.Number literal
+// ValueToNumberType ensures we have a types.Number literal, panicking if v is any other type
 func ValueToNumberType(v attr.Value) types.Number {
-	if vb, ok := v.(types.Number); ok {
-		return vb
-	} else if vb, ok := v.(*types.Number); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToNumberType", v))
-	}
+	return must(TryValueToNumberType(v))
+}
+
+// TryValueToNumberType ensures we have a types.Number literal, returning an error if v is any other type
+func TryValueToNumberType(v attr.Value) (types.Number, error) {
+	return valueToBaseType[types.Number]("value_to_number_type", v)
 }
 
-// ValueToObjectType ensures we have a types.Object literal
+// ValueToObjectType ensures we have a types.Object literal, panicking if v is any other type
 func ValueToObjectType(v attr.Value) types.Object {
-	if vb, ok := v.(types.Object); ok {
-		return vb
-	} else if vb, ok := v.(*types.Object); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToObjectType", v))
-	}
+	return must(TryValueToObjectType(v))
 }
 
-// ValueToSetType ensures we have a types.Set literal
+// TryValueToObjectType ensures we have a types.Object literal, returning an error if v is any other type
+func TryValueToObjectType(v attr.Value) (types.Object, error) {
+	return valueToBaseType[types.Object]("value_to_object_type", v)
+}
+
+// ValueToSetType ensures we have a types.Set literal, panicking if v is any other type
 func ValueToSetType(v attr.Value) types.Set {
-	if vb, ok := v.(types.Set); ok {
-		return vb
-	} else if vb, ok := v.(*types.Set); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.ValueToSetType", v))
-	}
+	return must(TryValueToSetType(v))
 }
 
-// ValueToStringType ensures we have a types.String literal
+// TryValueToSetType ensures we have a types.Set literal, returning an error if v is any other type
+func TryValueToSetType(v attr.Value) (types.Set, error) {
+	return valueToBaseType[types.Set]("value_to_set_type", v)
+}
+
+// ValueToStringType ensures we have a types.String literal, panicking if v is any other type
 func ValueToStringType(v attr.Value) types.String {
-	if vb, ok := v.(types.String); ok {
-		return vb
-	} else if vb, ok := v.(*types.String); ok {
-		return *vb
-	} else {
-		panic(fmt.Sprintf("cannot pass type %T to conv.StringValueToString", v))
-	}
+	return must(TryValueToStringType(v))
+}
+
+// TryValueToStringType ensures we have a types.String literal, returning an error if v is any other type
+func TryValueToStringType(v attr.Value) (types.String, error) {
+	return valueToBaseType[types.String]("value_to_string_type", v)
+}
+
+// ValueToTupleType ensures we have a types.Tuple literal, panicking if v is any other type
+func ValueToTupleType(v attr.Value) types.Tuple {
+	return must(TryValueToTupleType(v))
+}
+
+// TryValueToTupleType ensures we have a types.Tuple literal, returning an error if v is any other type
+func TryValueToTupleType(v attr.Value) (types.Tuple, error) {
+	return valueToBaseType[types.Tuple]("value_to_tuple_type", v)
 }
 
 // TestAttributeValueState - Determine the state of the attribute value
@@ -127,6 +149,8 @@ func ValueToStringType(v attr.Value) types.String {
 // A 'nil' response from this function means the attribute's value was defined to a non-"empty" value at runtime. See
 // function body for a particular type if you're interested in what "empty" means.
 func TestAttributeValueState(av attr.Value) error {
+	av = underlyingValueOrSelf(av)
+
 	var (
 		empty bool
 
@@ -151,7 +175,13 @@ func TestAttributeValueState(av attr.Value) error {
 		tv := ValueToSetType(av)
 		undefined = tv.IsUnknown()
 		null = tv.IsNull()
-		empty = AttributeValueLength(av) > 0
+		empty = AttributeValueLength(av) == 0
+
+	case types.Tuple, *types.Tuple:
+		tv := ValueToTupleType(av)
+		undefined = tv.IsUnknown()
+		null = tv.IsNull()
+		empty = AttributeValueLength(av) == 0
 
 	case types.String, *types.String:
 		tv := ValueToStringType(av)
@@ -211,6 +241,7 @@ func StringValueToBytes(v attr.Value) []byte {
 
 // AttributeValueToString will attempt to execute the appropriate AttributeStringerFunc from the ones registered.
 func AttributeValueToString(v attr.Value) string {
+	v = underlyingValueOrSelf(v)
 	if s, ok := v.(types.String); ok {
 		return s.ValueString()
 	}
@@ -219,67 +250,131 @@ func AttributeValueToString(v attr.Value) string {
 
 // AttributeValueToStrings attempts to convert the provided attr.Value into a slice of strings.
 func AttributeValueToStrings(av attr.Value) []string {
+	return must(TryAttributeValueToStrings(av))
+}
+
+// TryAttributeValueToStrings is the non-panicking form of AttributeValueToStrings
+func TryAttributeValueToStrings(av attr.Value) ([]string, error) {
+	av = underlyingValueOrSelf(av)
 	switch av.(type) {
 	case types.List, *types.List:
-		return StringListToStrings(av)
+		return TryStringListToStrings(av)
 
 	case types.Set, *types.Set:
-		return StringSetToStrings(av)
+		return TryStringSetToStrings(av)
 	default:
 		out := make([]string, 0)
 		out = append(out, AttributeValueToString(av))
-		return out
+		return out, nil
 	}
 }
 
 // LengthOfListValue returns the number of elements in the List attribute.  This will return 0 if the attribute was not set,
 // set to null, or defined as an empty list.
 func LengthOfListValue(v attr.Value) int {
-	return len(ValueToListType(v).Elements())
+	return must(TryLengthOfListValue(v))
+}
+
+// TryLengthOfListValue is the non-panicking form of LengthOfListValue
+func TryLengthOfListValue(v attr.Value) (int, error) {
+	vt, err := TryValueToListType(v)
+	return len(vt.Elements()), err
 }
 
 // LengthOfMapValue returns the number of elements in the Map attribute.  This will return 0 if the attribute was not set,
 // set to null, or defined as an empty map.
 func LengthOfMapValue(v attr.Value) int {
-	return len(ValueToMapType(v).Elements())
+	return must(TryLengthOfMapValue(v))
+}
+
+// TryLengthOfMapValue is the non-panicking form of LengthOfMapValue
+func TryLengthOfMapValue(v attr.Value) (int, error) {
+	vt, err := TryValueToMapType(v)
+	return len(vt.Elements()), err
 }
 
 // LengthOfSetValue returns the number of elements in the Set attribute.  This will return 0 if the attribute was not set,
 // set to null, or defined as an empty set.
 func LengthOfSetValue(v attr.Value) int {
-	return len(ValueToSetType(v).Elements())
+	return must(TryLengthOfSetValue(v))
+}
+
+// TryLengthOfSetValue is the non-panicking form of LengthOfSetValue
+func TryLengthOfSetValue(v attr.Value) (int, error) {
+	vt, err := TryValueToSetType(v)
+	return len(vt.Elements()), err
+}
+
+// LengthOfTupleValue returns the number of elements in the Tuple attribute.  This will return 0 if the attribute was
+// not set, set to null, or defined as an empty tuple.
+func LengthOfTupleValue(v attr.Value) int {
+	return must(TryLengthOfTupleValue(v))
+}
+
+// TryLengthOfTupleValue is the non-panicking form of LengthOfTupleValue
+func TryLengthOfTupleValue(v attr.Value) (int, error) {
+	vt, err := TryValueToTupleType(v)
+	return len(vt.Elements()), err
 }
 
 // LengthOfStringValue returns the number of bytes in the String attribute.  This will return 0 if the attribute was not set,
 // set to 0, or defined as an empty string.
 func LengthOfStringValue(v attr.Value) int {
-	return len(ValueToStringType(v).ValueString())
+	return must(TryLengthOfStringValue(v))
+}
+
+// TryLengthOfStringValue is the non-panicking form of LengthOfStringValue
+func TryLengthOfStringValue(v attr.Value) (int, error) {
+	vt, err := TryValueToStringType(v)
+	return len(vt.ValueString()), err
 }
 
 // AttributeValueLength attempts to determine the "length" of an attribute value, for types where that value has
 // significance.
 func AttributeValueLength(v attr.Value) int {
+	return must(TryAttributeValueLength(v))
+}
+
+// TryAttributeValueLength is the non-panicking form of AttributeValueLength
+func TryAttributeValueLength(v attr.Value) (int, error) {
+	v = underlyingValueOrSelf(v)
 	switch v.(type) {
 	case types.List, *types.List:
-		return LengthOfListValue(v)
+		return TryLengthOfListValue(v)
 
 	case types.Map, *types.Map:
-		return LengthOfMapValue(v)
+		return TryLengthOfMapValue(v)
 
 	case types.Set, *types.Set:
-		return LengthOfSetValue(v)
+		return TryLengthOfSetValue(v)
+
+	case types.Tuple, *types.Tuple:
+		return TryLengthOfTupleValue(v)
 
 	case types.String, *types.String:
-		return LengthOfStringValue(v)
+		return TryLengthOfStringValue(v)
+
+	case types.Dynamic:
+		// a dynamic value only remains here if it is null or unknown, or its underlying value could not be determined
+		if v.IsNull() || v.IsUnknown() {
+			return 0, nil
+		}
+		return 0, ValueTypeUnhandledError("attribute_value_length", v)
 
 	default:
-		panic(fmt.Sprintf("unable to determine length of attribute value of type %T", v))
+		return 0, ValueTypeUnhandledError("attribute_value_length", v)
 	}
 }
 
 // BoolValueToBool accepts either a types.Bool or *types.Bool and extracts the raw bool value within
 func BoolValueToBool(v attr.Value) bool {
-	return ValueToBoolType(v).ValueBool()
+	return must(TryBoolValueToBool(v))
+}
+
+// TryBoolValueToBool is the non-panicking form of BoolValueToBool
+func TryBoolValueToBool(v attr.Value) (bool, error) {
+	vt, err := TryValueToBoolType(v)
+	return vt.ValueBool(), err
 }
 
 // BoolValueToBoolPtr accepts either a types.Bool or *types.Bool, extracting the raw bool value within and returning
@@ -287,29 +382,48 @@ func BoolValueToBool(v attr.Value) bool {
 //
 // If the Value is unknown or null, a nil is returned.
 func BoolValueToBoolPtr(v attr.Value) *bool {
-	vt := ValueToBoolType(v)
-	if vt.IsUnknown() || vt.IsNull() {
-		return nil
+	return must(TryBoolValueToBoolPtr(v))
+}
+
+// TryBoolValueToBoolPtr is the non-panicking form of BoolValueToBoolPtr
+func TryBoolValueToBoolPtr(v attr.Value) (*bool, error) {
+	vt, err := TryValueToBoolType(v)
+	if err != nil || vt.IsUnknown() || vt.IsNull() {
+		return nil, err
 	}
 	vPtr := new(bool)
 	*vPtr = vt.ValueBool()
-	return vPtr
+	return vPtr, nil
 }
 
 // NumberValueToBigFloat accepts either a types.Number or *types.Number, returning the raw *big.Float value.  This may
 // be nil if the value was not set.
 func NumberValueToBigFloat(v attr.Value) *big.Float {
-	return ValueToNumberType(v).ValueBigFloat()
+	return must(TryNumberValueToBigFloat(v))
+}
+
+// TryNumberValueToBigFloat is the non-panicking form of NumberValueToBigFloat
+func TryNumberValueToBigFloat(v attr.Value) (*big.Float, error) {
+	vt, err := TryValueToNumberType(v)
+	return vt.ValueBigFloat(), err
 }
 
 // NumberValueToInt64 accepts either a types.Number or *types.Number, returning an int64 representation of the
 // *big.Float value within.  It will return [0, big.Exact] of the value was not set.
 func NumberValueToInt64(v attr.Value) (int64, big.Accuracy) {
-	vt := ValueToNumberType(v)
-	if vt.IsNull() || vt.IsUnknown() {
-		return 0, big.Exact
+	i, a, err := TryNumberValueToInt64(v)
+	must(i, err)
+	return i, a
+}
+
+// TryNumberValueToInt64 is the non-panicking form of NumberValueToInt64
+func TryNumberValueToInt64(v attr.Value) (int64, big.Accuracy, error) {
+	vt, err := TryValueToNumberType(v)
+	if err != nil || vt.IsNull() || vt.IsUnknown() {
+		return 0, big.Exact, err
 	}
-	return vt.ValueBigFloat().Int64()
+	i, a := vt.ValueBigFloat().Int64()
+	return i, a, nil
 }
 
 // NumberValueToInt accepts either a types.Number or *types.Number, returning an int representation of the *big.Float
@@ -319,19 +433,39 @@ func NumberValueToInt(v attr.Value) (int, big.Accuracy) {
 	return int(iv), acc
 }
 
+// TryNumberValueToInt is the non-panicking form of NumberValueToInt
+func TryNumberValueToInt(v attr.Value) (int, big.Accuracy, error) {
+	iv, acc, err := TryNumberValueToInt64(v)
+	return int(iv), acc, err
+}
+
 // NumberValueToFloat64 accepts either a types.Number or *types.Number, returning a float64 representation of the
 // *big.Float value within.  It will return [0.0, big.Exact] of the value was not set.
 func NumberValueToFloat64(v attr.Value) (float64, big.Accuracy) {
-	vt := ValueToNumberType(v)
-	if vt.IsUnknown() || vt.IsNull() {
-		return 0.0, big.Exact
+	f, a, err := TryNumberValueToFloat64(v)
+	must(f, err)
+	return f, a
+}
+
+// TryNumberValueToFloat64 is the non-panicking form of NumberValueToFloat64
+func TryNumberValueToFloat64(v attr.Value) (float64, big.Accuracy, error) {
+	vt, err := TryValueToNumberType(v)
+	if err != nil || vt.IsUnknown() || vt.IsNull() {
+		return 0.0, big.Exact, err
 	}
-	return vt.ValueBigFloat().Float64()
+	f, a := vt.ValueBigFloat().Float64()
+	return f, a, nil
 }
 
 // Int64ValueToInt64 accepts either a types.Int64 or *types.Int64, returning the raw int64 value within
 func Int64ValueToInt64(v attr.Value) int64 {
-	return ValueToInt64Type(v).ValueInt64()
+	return must(TryInt64ValueToInt64(v))
+}
+
+// TryInt64ValueToInt64 is the non-panicking form of Int64ValueToInt64
+func TryInt64ValueToInt64(v attr.Value) (int64, error) {
+	vt, err := TryValueToInt64Type(v)
+	return vt.ValueInt64(), err
 }
 
 // Int64ValueToInt accepts either a types.Int64 or *types.Int64, returning an int representation of the value within
@@ -339,23 +473,40 @@ func Int64ValueToInt(v attr.Value) int {
 	return int(Int64ValueToInt64(v))
 }
 
+// TryInt64ValueToInt is the non-panicking form of Int64ValueToInt
+func TryInt64ValueToInt(v attr.Value) (int, error) {
+	i, err := TryInt64ValueToInt64(v)
+	return int(i), err
+}
+
 // Int64ValueToIntPtr accepts either a types.Int64 or *types.Int64, returning a pointer to a copy of the int
 // representation of the value within
 //
 // If the Value is unknown or null, a nil is returned.
 func Int64ValueToIntPtr(v attr.Value) *int {
-	vt := ValueToInt64Type(v)
-	if vt.IsUnknown() || vt.IsNull() {
-		return nil
+	return must(TryInt64ValueToIntPtr(v))
+}
+
+// TryInt64ValueToIntPtr is the non-panicking form of Int64ValueToIntPtr
+func TryInt64ValueToIntPtr(v attr.Value) (*int, error) {
+	vt, err := TryValueToInt64Type(v)
+	if err != nil || vt.IsUnknown() || vt.IsNull() {
+		return nil, err
 	}
 	vPtr := new(int)
 	*vPtr = int(vt.ValueInt64())
-	return vPtr
+	return vPtr, nil
 }
 
 // Float64ValueToFloat64 accepts either a types.Float64 or *types.Float64, returning the raw float64 value within
 func Float64ValueToFloat64(v attr.Value) float64 {
-	return ValueToFloat64Type(v).ValueFloat64()
+	return must(TryFloat64ValueToFloat64(v))
+}
+
+// TryFloat64ValueToFloat64 is the non-panicking form of Float64ValueToFloat64
+func TryFloat64ValueToFloat64(v attr.Value) (float64, error) {
+	vt, err := TryValueToFloat64Type(v)
+	return vt.ValueFloat64(), err
 }
 
 // Float64ValueToFloat32 accepts either a types.Float64 or *types.Float64, returning a float32 representation of the
@@ -364,14 +515,132 @@ func Float64ValueToFloat32(v attr.Value) float32 {
 	return float32(Float64ValueToFloat64(v))
 }
 
+// TryFloat64ValueToFloat32 is the non-panicking form of Float64ValueToFloat32
+func TryFloat64ValueToFloat32(v attr.Value) (float32, error) {
+	f, err := TryFloat64ValueToFloat64(v)
+	return float32(f), err
+}
+
+// Int32ValueToInt32 accepts either a types.Int32 or *types.Int32, returning the raw int32 value within
+func Int32ValueToInt32(v attr.Value) int32 {
+	return must(TryInt32ValueToInt32(v))
+}
+
+// TryInt32ValueToInt32 is the non-panicking form of Int32ValueToInt32
+func TryInt32ValueToInt32(v attr.Value) (int32, error) {
+	vt, err := TryValueToInt32Type(v)
+	return vt.ValueInt32(), err
+}
+
+// Int32ValueToInt accepts either a types.Int32 or *types.Int32, returning an int representation of the value within
+func Int32ValueToInt(v attr.Value) int {
+	return must(TryInt32ValueToInt(v))
+}
+
+// TryInt32ValueToInt is the non-panicking form of Int32ValueToInt
+func TryInt32ValueToInt(v attr.Value) (int, error) {
+	i, err := TryInt32ValueToInt32(v)
+	return int(i), err
+}
+
+// Int32ValueToIntPtr accepts either a types.Int32 or *types.Int32, returning a pointer to a copy of the int
+// representation of the value within
+//
+// If the Value is unknown or null, a nil is returned.
+func Int32ValueToIntPtr(v attr.Value) *int {
+	return must(TryInt32ValueToIntPtr(v))
+}
+
+// TryInt32ValueToIntPtr is the non-panicking form of Int32ValueToIntPtr
+func TryInt32ValueToIntPtr(v attr.Value) (*int, error) {
+	vt, err := TryValueToInt32Type(v)
+	if err != nil || vt.IsUnknown() || vt.IsNull() {
+		return nil, err
+	}
+	vPtr := new(int)
+	*vPtr = int(vt.ValueInt32())
+	return vPtr, nil
+}
+
+// Int64ValueToInt32Value narrows either a types.Int64 or *types.Int64 to a types.Int32, panicking if the value
+// overflows an int32.  Null and unknown values are preserved.
+func Int64ValueToInt32Value(v attr.Value) types.Int32 {
+	return must(TryInt64ValueToInt32Value(v))
+}
+
+// TryInt64ValueToInt32Value is the non-panicking form of Int64ValueToInt32Value
+func TryInt64ValueToInt32Value(v attr.Value) (types.Int32, error) {
+	vt, err := TryValueToInt64Type(v)
+	if err != nil {
+		return types.Int32{}, err
+	}
+	if vt.IsNull() {
+		return types.Int32Null(), nil
+	} else if vt.IsUnknown() {
+		return types.Int32Unknown(), nil
+	}
+	return TryInt64ToInt32Value(vt.ValueInt64())
+}
+
+// Float32ValueToFloat32 accepts either a types.Float32 or *types.Float32, returning the raw float32 value within
+func Float32ValueToFloat32(v attr.Value) float32 {
+	return must(TryFloat32ValueToFloat32(v))
+}
+
+// TryFloat32ValueToFloat32 is the non-panicking form of Float32ValueToFloat32
+func TryFloat32ValueToFloat32(v attr.Value) (float32, error) {
+	vt, err := TryValueToFloat32Type(v)
+	return vt.ValueFloat32(), err
+}
+
+// Float32ValueToFloat64 accepts either a types.Float32 or *types.Float32, returning a float64 representation of the
+// raw float32 value
+func Float32ValueToFloat64(v attr.Value) float64 {
+	return must(TryFloat32ValueToFloat64(v))
+}
+
+// TryFloat32ValueToFloat64 is the non-panicking form of Float32ValueToFloat64
+func TryFloat32ValueToFloat64(v attr.Value) (float64, error) {
+	f, err := TryFloat32ValueToFloat32(v)
+	return float64(f), err
+}
+
+// Float64ValueToFloat32Value narrows either a types.Float64 or *types.Float64 to a types.Float32, panicking if the
+// value overflows a float32.  Null and unknown values are preserved.
+func Float64ValueToFloat32Value(v attr.Value) types.Float32 {
+	return must(TryFloat64ValueToFloat32Value(v))
+}
+
+// TryFloat64ValueToFloat32Value is the non-panicking form of Float64ValueToFloat32Value
+func TryFloat64ValueToFloat32Value(v attr.Value) (types.Float32, error) {
+	vt, err := TryValueToFloat64Type(v)
+	if err != nil {
+		return types.Float32{}, err
+	}
+	if vt.IsNull() {
+		return types.Float32Null(), nil
+	} else if vt.IsUnknown() {
+		return types.Float32Unknown(), nil
+	}
+	return TryFloat64ToFloat32Value(vt.ValueFloat64())
+}
+
 // StringValueToFloat64 accepts either a types.String or *types.string, attempting to parse the value as a float64
 func StringValueToFloat64(v attr.Value) (float64, error) {
-	return strconv.ParseFloat(ValueToStringType(v).ValueString(), 64)
+	vt, err := TryValueToStringType(v)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(vt.ValueString(), 64)
 }
 
 // StringValueToInt64 accepts either a types.String or *types.String, attempting to parse the value as an int64.
 func StringValueToInt64(v attr.Value) (int, error) {
-	return strconv.Atoi(ValueToStringType(v).ValueString())
+	vt, err := TryValueToStringType(v)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(vt.ValueString())
 }
 
 // StringValueToStringPtr accepts an instance of either types.String or *types.String, returning a pointer to a copy
@@ -379,93 +648,184 @@ func StringValueToInt64(v attr.Value) (int, error) {
 //
 // If the Value is unknown or null, a nil is returned.
 func StringValueToStringPtr(v attr.Value) *string {
-	vt := ValueToStringType(v)
-	if vt.IsUnknown() || vt.IsNull() {
-		return nil
+	return must(TryStringValueToStringPtr(v))
+}
+
+// TryStringValueToStringPtr is the non-panicking form of StringValueToStringPtr
+func TryStringValueToStringPtr(v attr.Value) (*string, error) {
+	vt, err := TryValueToStringType(v)
+	if err != nil || vt.IsUnknown() || vt.IsNull() {
+		return nil, err
 	}
 	vPtr := new(string)
 	*vPtr = vt.ValueString()
-	return vPtr
+	return vPtr, nil
 }
 
 // StringListToStrings accepts an instance of either types.List or *types.List where ElementType MUST be types.StringType,
 // returning a slice of strings of the value of each element
 func StringListToStrings(v attr.Value) []string {
-	vt := ValueToListType(v)
-	out := make([]string, len(vt.Elements()))
-	for i, ve := range vt.Elements() {
-		out[i] = AttributeValueToString(ve)
+	return must(TryStringListToStrings(v))
+}
+
+// TryStringListToStrings is the non-panicking form of StringListToStrings
+func TryStringListToStrings(v attr.Value) ([]string, error) {
+	vt, err := TryValueToListType(v)
+	if err != nil {
+		return nil, err
 	}
-	return out
+	return lenientSliceToGo[string](vt)
 }
 
 // StringSetToStrings accepts an instance of either types.Set or *types.Set where ElementType MUST be types.StringType,
 // returning a slice of strings of the value of each element
 func StringSetToStrings(v attr.Value) []string {
-	vt := ValueToSetType(v)
-	out := make([]string, len(vt.Elements()))
-	for i, ve := range vt.Elements() {
-		out[i] = AttributeValueToString(ve)
+	return must(TryStringSetToStrings(v))
+}
+
+// TryStringSetToStrings is the non-panicking form of StringSetToStrings
+func TryStringSetToStrings(v attr.Value) ([]string, error) {
+	vt, err := TryValueToSetType(v)
+	if err != nil {
+		return nil, err
 	}
-	return out
+	return lenientSliceToGo[string](vt)
 }
 
 // Int64ListToInts accepts an instance of either types.List or *types.List where ElementType MUST be types.Int64Type,
 // returning a slice of ints of the value of each element.
 func Int64ListToInts(v attr.Value) []int {
-	vt := ValueToListType(v)
-	out := make([]int, len(vt.Elements()))
-	for i, ve := range vt.Elements() {
-		out[i] = Int64ValueToInt(ve)
+	return must(TryInt64ListToInts(v))
+}
+
+// TryInt64ListToInts is the non-panicking form of Int64ListToInts
+func TryInt64ListToInts(v attr.Value) ([]int, error) {
+	vt, err := TryValueToListType(v)
+	if err != nil {
+		return nil, err
 	}
-	return out
+	return lenientSliceToGo[int](vt)
 }
 
 // Int64SetToInts accepts an instance of either types.Set or *types.set where ElementType MUST be types.Int64Type
 // returning a slice of ints of the value of each element
 func Int64SetToInts(v attr.Value) []int {
-	vt := ValueToSetType(v)
-	out := make([]int, len(vt.Elements()))
-	for i, ve := range vt.Elements() {
-		out[i] = Int64ValueToInt(ve)
+	return must(TryInt64SetToInts(v))
+}
+
+// TryInt64SetToInts is the non-panicking form of Int64SetToInts
+func TryInt64SetToInts(v attr.Value) ([]int, error) {
+	vt, err := TryValueToSetType(v)
+	if err != nil {
+		return nil, err
 	}
-	return out
+	return lenientSliceToGo[int](vt)
 }
 
 // NumberListToInts accepts either an instance of types.List or *types.List where ElementType MUST be types.NumberType
 // returning a slice of ints of the value of each element
 func NumberListToInts(v attr.Value) []int {
-	vt := ValueToListType(v)
-	out := make([]int, len(vt.Elements()))
-	for i, ve := range vt.Elements() {
-		iv, _ := NumberValueToInt(ve)
-		out[i] = iv
+	return must(TryNumberListToInts(v))
+}
+
+// TryNumberListToInts is the non-panicking form of NumberListToInts
+func TryNumberListToInts(v attr.Value) ([]int, error) {
+	vt, err := TryValueToListType(v)
+	if err != nil {
+		return nil, err
 	}
-	return out
+	return numberElementsToInts(vt.Elements())
 }
 
 // NumberSetToInts accepts either an instance of types.Set or *types.Set where ElementType MUST be types.NumberType
 // returning a slice of ints of the value of each element
 func NumberSetToInts(v attr.Value) []int {
-	vt := ValueToSetType(v)
-	out := make([]int, len(vt.Elements()))
-	for i, ve := range vt.Elements() {
-		iv, _ := NumberValueToInt(ve)
+	return must(TryNumberSetToInts(v))
+}
+
+// TryNumberSetToInts is the non-panicking form of NumberSetToInts
+func TryNumberSetToInts(v attr.Value) ([]int, error) {
+	vt, err := TryValueToSetType(v)
+	if err != nil {
+		return nil, err
+	}
+	return numberElementsToInts(vt.Elements())
+}
+
+func numberElementsToInts(elems []attr.Value) ([]int, error) {
+	out := make([]int, len(elems))
+	for i, ve := range elems {
+		iv, _, err := TryNumberValueToInt(ve)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
 		out[i] = iv
 	}
-	return out
+	return out, nil
+}
+
+// Int32ListToInts accepts an instance of either types.List or *types.List where ElementType MUST be types.Int32Type,
+// returning a slice of ints of the value of each element.
+func Int32ListToInts(v attr.Value) []int {
+	return must(TryInt32ListToInts(v))
+}
+
+// TryInt32ListToInts is the non-panicking form of Int32ListToInts
+func TryInt32ListToInts(v attr.Value) ([]int, error) {
+	vt, err := TryValueToListType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientSliceToGo[int](vt)
+}
+
+// Int32SetToInts accepts an instance of either types.Set or *types.Set where ElementType MUST be types.Int32Type,
+// returning a slice of ints of the value of each element
+func Int32SetToInts(v attr.Value) []int {
+	return must(TryInt32SetToInts(v))
+}
+
+// TryInt32SetToInts is the non-panicking form of Int32SetToInts
+func TryInt32SetToInts(v attr.Value) ([]int, error) {
+	vt, err := TryValueToSetType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientSliceToGo[int](vt)
+}
+
+// TupleToInterfaces accepts an instance of either types.Tuple or *types.Tuple, returning a slice containing the Go
+// representation of each element, as ToGo[interface{}] would convert it.
+func TupleToInterfaces(v attr.Value) []interface{} {
+	return must(TryTupleToInterfaces(v))
+}
+
+// TryTupleToInterfaces is the non-panicking form of TupleToInterfaces
+func TryTupleToInterfaces(v attr.Value) ([]interface{}, error) {
+	vt, err := TryValueToTupleType(v)
+	if err != nil {
+		return nil, err
+	}
+	return lenientSliceToGo[interface{}](vt)
 }
 
 // AttributeValueToFloat64 accepts either a literal or pointer to a concrete attr.Value implementation, attempting to
 // to return a float64 representation of its value.
 func AttributeValueToFloat64(v attr.Value) (float64, big.Accuracy, error) {
+	v = underlyingValueOrSelf(v)
 	switch v.(type) {
 	case types.Float64, *types.Float64:
 		return Float64ValueToFloat64(v), big.Exact, nil
 
+	case types.Float32, *types.Float32:
+		return Float32ValueToFloat64(v), big.Exact, nil
+
 	case types.Int64, *types.Int64:
 		return float64(Int64ValueToInt64(v)), big.Exact, nil
 
+	case types.Int32, *types.Int32:
+		return float64(Int32ValueToInt32(v)), big.Exact, nil
+
 	case types.Number, *types.Number:
 		f, a := NumberValueToFloat64(v)
 		return f, a, nil
@@ -482,9 +842,10 @@ func AttributeValueToFloat64(v attr.Value) (float64, big.Accuracy, error) {
 // AttributeValueToInt64 accepts either a literal or pointer to a concrete attr.Value implementation, attempting to
 // return an int64 representation of its value.
 func AttributeValueToInt64(v attr.Value) (int64, big.Accuracy, error) {
+	v = underlyingValueOrSelf(v)
 	switch v.(type) {
-	case types.Float64, *types.Float64:
-		f := Float64ValueToFloat64(v)
+	case types.Float64, *types.Float64, types.Float32, *types.Float32:
+		f, _, _ := AttributeValueToFloat64(v)
 		i := int64(f)
 		if f > float64(i) {
 			return i, big.Below, nil
@@ -495,6 +856,9 @@ func AttributeValueToInt64(v attr.Value) (int64, big.Accuracy, error) {
 	case types.Int64, *types.Int64:
 		return Int64ValueToInt64(v), big.Exact, nil
 
+	case types.Int32, *types.Int32:
+		return int64(Int32ValueToInt32(v)), big.Exact, nil
+
 	case types.Number, *types.Number:
 		i, a := NumberValueToInt64(v)
 		return i, a, nil
@@ -511,13 +875,20 @@ func AttributeValueToInt64(v attr.Value) (int64, big.Accuracy, error) {
 // AttributeValueToBigFloat accepts either a literal or pointer to a concrete attr.Value implementation, attempting to
 // return a *big.Float instance of its value.
 func AttributeValueToBigFloat(v attr.Value) (*big.Float, error) {
+	v = underlyingValueOrSelf(v)
 	switch v.(type) {
 	case types.Float64, *types.Float64:
 		return big.NewFloat(Float64ValueToFloat64(v)), nil
 
+	case types.Float32, *types.Float32:
+		return big.NewFloat(Float32ValueToFloat64(v)), nil
+
 	case types.Int64, *types.Int64:
 		return big.NewFloat(0).SetInt64(Int64ValueToInt64(v)), nil
 
+	case types.Int32, *types.Int32:
+		return big.NewFloat(0).SetInt64(int64(Int32ValueToInt32(v))), nil
+
 	case types.Number, *types.Number:
 		return NumberValueToBigFloat(v), nil
 
@@ -598,6 +969,68 @@ func Float32ToNumberValue(f float32) types.Number {
 	return Float64ToNumberValue(float64(f))
 }
 
+// Int32ToInt32Value takes an int32 and wraps it up as a types.Int32
+func Int32ToInt32Value(i int32) types.Int32 {
+	return types.Int32Value(i)
+}
+
+// Int64ToInt32Value takes an int64 and wraps it up as a types.Int32, panicking if it overflows an int32
+func Int64ToInt32Value(i int64) types.Int32 {
+	return must(TryInt64ToInt32Value(i))
+}
+
+// TryInt64ToInt32Value is the non-panicking form of Int64ToInt32Value
+func TryInt64ToInt32Value(i int64) (types.Int32, error) {
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return types.Int32{}, ValueOverflowError("int64_to_int32_value", i, "int32")
+	}
+	return types.Int32Value(int32(i)), nil
+}
+
+// IntToInt32Value takes an int and wraps it up as a types.Int32, panicking if it overflows an int32
+func IntToInt32Value(i int) types.Int32 {
+	return must(TryIntToInt32Value(i))
+}
+
+// TryIntToInt32Value is the non-panicking form of IntToInt32Value
+func TryIntToInt32Value(i int) (types.Int32, error) {
+	return TryInt64ToInt32Value(int64(i))
+}
+
+// IntPtrToInt32Value takes an *int and wraps it up as a types.Int32, panicking if it overflows an int32
+//
+// If the go value is nil, Null will be true on the outgoing attr.Value type
+func IntPtrToInt32Value(i *int) types.Int32 {
+	return must(TryIntPtrToInt32Value(i))
+}
+
+// TryIntPtrToInt32Value is the non-panicking form of IntPtrToInt32Value
+func TryIntPtrToInt32Value(i *int) (types.Int32, error) {
+	if i == nil {
+		return types.Int32Null(), nil
+	}
+	return TryIntToInt32Value(*i)
+}
+
+// Float32ToFloat32Value takes a float32 and wraps it up as a types.Float32
+func Float32ToFloat32Value(f float32) types.Float32 {
+	return types.Float32Value(f)
+}
+
+// Float64ToFloat32Value takes a float64 and wraps it up as a types.Float32, panicking if it overflows a float32.
+// Precision beyond that of a float32 is silently lost.
+func Float64ToFloat32Value(f float64) types.Float32 {
+	return must(TryFloat64ToFloat32Value(f))
+}
+
+// TryFloat64ToFloat32Value is the non-panicking form of Float64ToFloat32Value
+func TryFloat64ToFloat32Value(f float64) (types.Float32, error) {
+	if !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+		return types.Float32{}, ValueOverflowError("float64_to_float32_value", f, "float32")
+	}
+	return types.Float32Value(float32(f)), nil
+}
+
 // StringToStringValue takes a string and wraps it up as a types.String
 // DEPRECATED: use types.StringValue() directly
 func StringToStringValue(s string) types.String {
@@ -629,18 +1062,11 @@ func StringPtrToStringValue(s *string) types.String {
 // If nullOnEmpty parameter is `true`, the returned types.List will be set to Null.  This can be used to
 // avoid Terraform state inconsistencies under certain circumstances.
 func StringsToStringList(in []string, nullOnEmpty bool) types.List {
-	inLen := len(in)
-
-	if nullOnEmpty && inLen == 0 {
+	if nullOnEmpty && len(in) == 0 {
 		return types.ListNull(types.StringType)
 	}
 
-	elems := make([]attr.Value, inLen)
-	for i, n := range in {
-		elems[i] = types.StringValue(n)
-	}
-
-	return types.ListValueMust(types.StringType, elems)
+	return mustFromGo("StringsToStringList", nonNilSlice(in), types.ListType{ElemType: types.StringType}).(types.List)
 }
 
 // StringsToStringSet takes a slice of strings and creates a typed types.Set with an ElementType of types.String
@@ -649,18 +1075,11 @@ func StringsToStringList(in []string, nullOnEmpty bool) types.List {
 // If nullOnEmpty parameter is `true`, the returned types.Set will be set to Null.  This can be used to
 // avoid Terraform state inconsistencies under certain circumstances.
 func StringsToStringSet(in []string, nullOnEmpty bool) types.Set {
-	inLen := len(in)
-
-	if nullOnEmpty && inLen == 0 {
+	if nullOnEmpty && len(in) == 0 {
 		return types.SetNull(types.StringType)
 	}
 
-	elems := make([]attr.Value, inLen)
-	for i, n := range in {
-		elems[i] = types.StringValue(n)
-	}
-
-	return types.SetValueMust(types.StringType, elems)
+	return mustFromGo("StringsToStringSet", nonNilSlice(in), types.SetType{ElemType: types.StringType}).(types.Set)
 }
 
 // IntsToInt64List takes a slice of ints and creates a typed types.List with a ElementType of types.Int64Type and each
@@ -669,18 +1088,11 @@ func StringsToStringSet(in []string, nullOnEmpty bool) types.Set {
 // If nullOnEmpty parameter is `true`, the returned types.List will be set to Null.  This can be used to
 // avoid Terraform state inconsistencies under certain circumstances.
 func IntsToInt64List(in []int, nullOnEmpty bool) types.List {
-	inLen := len(in)
-
-	if nullOnEmpty && inLen == 0 {
+	if nullOnEmpty && len(in) == 0 {
 		return types.ListNull(types.Int64Type)
 	}
 
-	elems := make([]attr.Value, inLen)
-	for i, n := range in {
-		elems[i] = IntToInt64Value(n)
-	}
-
-	return types.ListValueMust(types.Int64Type, elems)
+	return mustFromGo("IntsToInt64List", nonNilSlice(in), types.ListType{ElemType: types.Int64Type}).(types.List)
 }
 
 // IntsToInt64Set takes a slice of ints and creates a typed types.Set with an ElementType of types.Int64Type and each
@@ -689,16 +1101,82 @@ func IntsToInt64List(in []int, nullOnEmpty bool) types.List {
 // If nullOnEmpty parameter is `true`, the returned types.Set will be set to Null.  This can be used to
 // avoid Terraform state inconsistencies under certain circumstances.
 func IntsToInt64Set(in []int, nullOnEmpty bool) types.Set {
-	inLen := len(in)
-
-	if nullOnEmpty && inLen == 0 {
+	if nullOnEmpty && len(in) == 0 {
 		return types.SetNull(types.Int64Type)
 	}
 
-	elems := make([]attr.Value, inLen)
-	for i, n := range in {
-		elems[i] = IntToInt64Value(n)
+	return mustFromGo("IntsToInt64Set", nonNilSlice(in), types.SetType{ElemType: types.Int64Type}).(types.Set)
+}
+
+// IntsToInt32List takes a slice of ints and creates a typed types.List with a ElementType of types.Int32Type and each
+// value of Elements being an instance of types.Int32, panicking if any value overflows an int32
+//
+// If nullOnEmpty parameter is `true`, the returned types.List will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func IntsToInt32List(in []int, nullOnEmpty bool) types.List {
+	return must(TryIntsToInt32List(in, nullOnEmpty))
+}
+
+// TryIntsToInt32List is the non-panicking form of IntsToInt32List
+func TryIntsToInt32List(in []int, nullOnEmpty bool) (types.List, error) {
+	if nullOnEmpty && len(in) == 0 {
+		return types.ListNull(types.Int32Type), nil
+	}
+	if err := checkInt32Overflow("ints_to_int32_list", in); err != nil {
+		return types.List{}, err
+	}
+
+	return mustFromGo("IntsToInt32List", nonNilSlice(in), types.ListType{ElemType: types.Int32Type}).(types.List), nil
+}
+
+// IntsToInt32Set takes a slice of ints and creates a typed types.Set with an ElementType of types.Int32Type and each
+// value of Elements being an instance of types.Int32, panicking if any value overflows an int32
+//
+// If nullOnEmpty parameter is `true`, the returned types.Set will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func IntsToInt32Set(in []int, nullOnEmpty bool) types.Set {
+	return must(TryIntsToInt32Set(in, nullOnEmpty))
+}
+
+// TryIntsToInt32Set is the non-panicking form of IntsToInt32Set
+func TryIntsToInt32Set(in []int, nullOnEmpty bool) (types.Set, error) {
+	if nullOnEmpty && len(in) == 0 {
+		return types.SetNull(types.Int32Type), nil
+	}
+	if err := checkInt32Overflow("ints_to_int32_set", in); err != nil {
+		return types.Set{}, err
 	}
 
-	return types.SetValueMust(types.Int64Type, elems)
+	return mustFromGo("IntsToInt32Set", nonNilSlice(in), types.SetType{ElemType: types.Int32Type}).(types.Set), nil
+}
+
+// InterfacesToTuple takes a slice of Go values and creates a types.Tuple, inferring the type of each element as
+// FromGoDynamic does, panicking if any element cannot be converted
+//
+// If nullOnEmpty parameter is `true`, the returned types.Tuple will be set to Null.  This can be used to
+// avoid Terraform state inconsistencies under certain circumstances.
+func InterfacesToTuple(in []interface{}, nullOnEmpty bool) types.Tuple {
+	return must(TryInterfacesToTuple(in, nullOnEmpty))
+}
+
+// TryInterfacesToTuple is the non-panicking form of InterfacesToTuple
+func TryInterfacesToTuple(in []interface{}, nullOnEmpty bool) (types.Tuple, error) {
+	if nullOnEmpty && len(in) == 0 {
+		return types.TupleNull(make([]attr.Type, 0)), nil
+	}
+
+	dv, err := FromGoDynamic(nonNilSlice(in))
+	if err != nil {
+		return types.Tuple{}, err
+	}
+	return TryValueToTupleType(dv)
+}
+
+func checkInt32Overflow(scope string, in []int) error {
+	for _, i := range in {
+		if i < math.MinInt32 || i > math.MaxInt32 {
+			return ValueOverflowError(scope, i, "int32")
+		}
+	}
+	return nil
 }
@@ -0,0 +1,155 @@
+package conv_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+)
+
+func TestTryConversions(t *testing.T) {
+	var nilString *types.String
+
+	theTests := []struct {
+		name string
+		fn   func() error
+	}{
+		{
+			name: "value-to-bool-type",
+			fn: func() error {
+				_, err := conv.TryValueToBoolType(types.StringValue("true"))
+				return err
+			},
+		},
+		{
+			name: "nil-pointer",
+			fn: func() error {
+				_, err := conv.TryValueToStringType(nilString)
+				return err
+			},
+		},
+		{
+			name: "attribute-value-length",
+			fn: func() error {
+				_, err := conv.TryAttributeValueLength(types.BoolValue(true))
+				return err
+			},
+		},
+		{
+			name: "int64-value-to-int-ptr",
+			fn: func() error {
+				_, err := conv.TryInt64ValueToIntPtr(types.Float64Value(1))
+				return err
+			},
+		},
+		{
+			name: "string-list-to-strings",
+			fn: func() error {
+				_, err := conv.TryStringListToStrings(types.SetValueMust(types.StringType, nil))
+				return err
+			},
+		},
+		{
+			name: "number-value-to-int64",
+			fn: func() error {
+				_, _, err := conv.TryNumberValueToInt64(types.Int64Value(1))
+				return err
+			},
+		},
+	}
+
+	for _, theT := range theTests {
+		t.Run(theT.name, func(t *testing.T) {
+			err := theT.fn()
+			assert.True(t, conv.IsValueTypeUnhandledError(err), "Expected unhandled type error, saw %v", err)
+		})
+	}
+
+	b, err := conv.TryBoolValueToBoolPtr(types.BoolNull())
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	ss, err := conv.TryAttributeValueToStrings(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, ss)
+
+	assert.Panics(t, func() { conv.AttributeValueLength(types.BoolValue(true)) })
+}
+
+func TestConversionDiagnostics(t *testing.T) {
+	assert.Nil(t, conv.ConversionDiagnostics(path.Root("name"), nil))
+
+	_, err := conv.TryValueToStringType(types.BoolValue(true))
+	diags := conv.ConversionDiagnostics(path.Root("name"), err)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.SeverityError, diags[0].Severity())
+		assert.Equal(t, "Unhandled Value Type", diags[0].Summary())
+		if wp, ok := diags[0].(diag.DiagnosticWithPath); assert.True(t, ok) {
+			assert.Equal(t, path.Root("name"), wp.Path())
+		}
+	}
+}
+
+func TestNarrowingConversions(t *testing.T) {
+	i, err := conv.TryInt64ValueToInt32Value(types.Int64Value(math.MaxInt32))
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.Int32Value(math.MaxInt32), i)
+	}
+	_, err = conv.TryInt64ValueToInt32Value(types.Int64Value(math.MaxInt32 + 1))
+	assert.True(t, conv.IsValueOverflowError(err))
+	assert.Equal(t, types.Int32Null(), conv.Int64ValueToInt32Value(types.Int64Null()))
+	assert.Equal(t, types.Int32Unknown(), conv.Int64ValueToInt32Value(types.Int64Unknown()))
+
+	f, err := conv.TryFloat64ValueToFloat32Value(types.Float64Value(1.5))
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.Float32Value(1.5), f)
+	}
+	_, err = conv.TryFloat64ValueToFloat32Value(types.Float64Value(math.MaxFloat64))
+	assert.True(t, conv.IsValueOverflowError(err))
+	assert.Equal(t, types.Float32Value(float32(math.Inf(-1))), conv.Float64ToFloat32Value(math.Inf(-1)))
+
+	_, err = conv.TryIntsToInt32List([]int{1, math.MinInt32 - 1}, false)
+	assert.True(t, conv.IsValueOverflowError(err))
+	assert.Equal(t, []int{1, 2}, conv.Int32ListToInts(conv.IntsToInt32List([]int{1, 2}, false)))
+	assert.Equal(t, []int{3}, conv.Int32SetToInts(conv.IntsToInt32Set([]int{3}, false)))
+	assert.True(t, conv.IntsToInt32Set(nil, true).IsNull())
+
+	assert.Equal(t, 7, *conv.Int32ValueToIntPtr(types.Int32Value(7)))
+	assert.Nil(t, conv.Int32ValueToIntPtr(types.Int32Null()))
+	assert.Equal(t, float64(2.5), conv.Float32ValueToFloat64(types.Float32Value(2.5)))
+
+	i64, acc, err := conv.AttributeValueToInt64(types.Float32Value(2.5))
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), i64)
+		assert.Equal(t, big.Below, acc)
+	}
+}
+
+func TestTupleConversions(t *testing.T) {
+	tv := conv.InterfacesToTuple([]interface{}{"a", true, 1}, false)
+	assert.Equal(t, []attr.Type{types.StringType, types.BoolType, types.NumberType}, tv.ElementTypes(context.Background()))
+	assert.Equal(t, 3, conv.AttributeValueLength(tv))
+	out := conv.TupleToInterfaces(tv)
+	if assert.Len(t, out, 3) {
+		assert.Equal(t, []interface{}{"a", true}, out[:2])
+		assert.Equal(t, 0, big.NewFloat(1).Cmp(out[2].(*big.Float)))
+	}
+
+	assert.True(t, conv.InterfacesToTuple(nil, true).IsNull())
+	assert.True(t, conv.IsValueIsEmptyError(conv.TestAttributeValueState(conv.InterfacesToTuple(nil, false))))
+}
+
+func TestNullCollectionsToSlices(t *testing.T) {
+	assert.Equal(t, []string{}, conv.StringListToStrings(types.ListNull(types.StringType)))
+	assert.Equal(t, []string{}, conv.StringSetToStrings(types.SetUnknown(types.StringType)))
+	assert.Equal(t, []int{}, conv.Int64ListToInts(types.ListUnknown(types.Int64Type)))
+	assert.Equal(t, []int{}, conv.Int64SetToInts(types.SetNull(types.Int64Type)))
+}
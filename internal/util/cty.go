@@ -0,0 +1,327 @@
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TerraformTypeToCtyType returns the cty.Type equivalent of the provided tftypes.Type
+func TerraformTypeToCtyType(typ tftypes.Type) (cty.Type, error) {
+	switch {
+	case typ == nil:
+		return cty.NilType, fmt.Errorf("cannot convert nil tftypes.Type to cty.Type")
+	case typ.Is(tftypes.DynamicPseudoType):
+		return cty.DynamicPseudoType, nil
+	case typ.Is(tftypes.String):
+		return cty.String, nil
+	case typ.Is(tftypes.Number):
+		return cty.Number, nil
+	case typ.Is(tftypes.Bool):
+		return cty.Bool, nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.List:
+		et, err := TerraformTypeToCtyType(t.ElementType)
+		return cty.List(et), err
+	case tftypes.Set:
+		et, err := TerraformTypeToCtyType(t.ElementType)
+		return cty.Set(et), err
+	case tftypes.Map:
+		et, err := TerraformTypeToCtyType(t.ElementType)
+		return cty.Map(et), err
+	case tftypes.Tuple:
+		ets := make([]cty.Type, len(t.ElementTypes))
+		for i, et := range t.ElementTypes {
+			ct, err := TerraformTypeToCtyType(et)
+			if err != nil {
+				return cty.NilType, fmt.Errorf("[%d]: %w", i, err)
+			}
+			ets[i] = ct
+		}
+		return cty.Tuple(ets), nil
+	case tftypes.Object:
+		ats := make(map[string]cty.Type, len(t.AttributeTypes))
+		for k, at := range t.AttributeTypes {
+			ct, err := TerraformTypeToCtyType(at)
+			if err != nil {
+				return cty.NilType, fmt.Errorf("%s: %w", k, err)
+			}
+			ats[k] = ct
+		}
+		if len(t.OptionalAttributes) > 0 {
+			return cty.ObjectWithOptionalAttrs(ats, SortedKeys(t.OptionalAttributes)), nil
+		}
+		return cty.Object(ats), nil
+	}
+
+	return cty.NilType, fmt.Errorf("cannot convert tftypes.Type %s to cty.Type", typ)
+}
+
+// CtyTypeToTerraformType returns the tftypes.Type equivalent of the provided cty.Type
+func CtyTypeToTerraformType(typ cty.Type) (tftypes.Type, error) {
+	switch {
+	case typ == cty.NilType:
+		return nil, fmt.Errorf("cannot convert cty.NilType to tftypes.Type")
+	case typ == cty.DynamicPseudoType:
+		return tftypes.DynamicPseudoType, nil
+	case typ == cty.String:
+		return tftypes.String, nil
+	case typ == cty.Number:
+		return tftypes.Number, nil
+	case typ == cty.Bool:
+		return tftypes.Bool, nil
+
+	case typ.IsListType():
+		et, err := CtyTypeToTerraformType(typ.ElementType())
+		return tftypes.List{ElementType: et}, err
+	case typ.IsSetType():
+		et, err := CtyTypeToTerraformType(typ.ElementType())
+		return tftypes.Set{ElementType: et}, err
+	case typ.IsMapType():
+		et, err := CtyTypeToTerraformType(typ.ElementType())
+		return tftypes.Map{ElementType: et}, err
+
+	case typ.IsTupleType():
+		ets := make([]tftypes.Type, typ.Length())
+		for i, et := range typ.TupleElementTypes() {
+			tt, err := CtyTypeToTerraformType(et)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			ets[i] = tt
+		}
+		return tftypes.Tuple{ElementTypes: ets}, nil
+
+	case typ.IsObjectType():
+		ats := make(map[string]tftypes.Type, len(typ.AttributeTypes()))
+		var optional map[string]struct{}
+		for k, at := range typ.AttributeTypes() {
+			tt, err := CtyTypeToTerraformType(at)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			ats[k] = tt
+			if typ.AttributeOptional(k) {
+				if optional == nil {
+					optional = make(map[string]struct{})
+				}
+				optional[k] = struct{}{}
+			}
+		}
+		return tftypes.Object{AttributeTypes: ats, OptionalAttributes: optional}, nil
+	}
+
+	return nil, fmt.Errorf("cannot convert cty.Type %s to tftypes.Type", typ.FriendlyName())
+}
+
+// TerraformValueToCtyValue converts the provided tftypes.Value into a cty.Value.  Null and unknown values are preserved
+// at every level of nesting.
+func TerraformValueToCtyValue(tv tftypes.Value) (cty.Value, error) {
+	ct, err := TerraformTypeToCtyType(tv.Type())
+	if err != nil {
+		return cty.NilVal, err
+	}
+	if !tv.IsKnown() {
+		return cty.UnknownVal(ct), nil
+	}
+	if tv.IsNull() {
+		return cty.NullVal(ct), nil
+	}
+
+	typ := tv.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err = tv.As(&s); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.StringVal(s), nil
+
+	case typ.Is(tftypes.Number):
+		bf := new(big.Float)
+		if err = tv.As(&bf); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.NumberVal(bf), nil
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err = tv.As(&b); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.BoolVal(b), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err = tv.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+		vals := make([]cty.Value, len(elems))
+		for i, elem := range elems {
+			if vals[i], err = TerraformValueToCtyValue(elem); err != nil {
+				return cty.NilVal, fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		switch {
+		case ct.IsTupleType():
+			return cty.TupleVal(vals), nil
+		case len(vals) == 0 && ct.IsListType():
+			return cty.ListValEmpty(ct.ElementType()), nil
+		case len(vals) == 0:
+			return cty.SetValEmpty(ct.ElementType()), nil
+		case ct.IsListType():
+			return cty.ListVal(vals), nil
+		default:
+			return cty.SetVal(vals), nil
+		}
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err = tv.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+		vals := make(map[string]cty.Value, len(elems))
+		for k, elem := range elems {
+			if vals[k], err = TerraformValueToCtyValue(elem); err != nil {
+				return cty.NilVal, fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		switch {
+		case ct.IsObjectType():
+			return cty.ObjectVal(vals), nil
+		case len(vals) == 0:
+			return cty.MapValEmpty(ct.ElementType()), nil
+		default:
+			return cty.MapVal(vals), nil
+		}
+	}
+
+	return cty.NilVal, fmt.Errorf("cannot convert tftypes.Value of type %s to cty.Value", typ)
+}
+
+// CtyValueToTerraformValue converts the provided cty.Value into a tftypes.Value of the provided type.  Where typ is,
+// or contains, tftypes.DynamicPseudoType, the type of the corresponding cty.Value is used.  Null and unknown values are
+// preserved at every level of nesting.  The value must not be marked.
+func CtyValueToTerraformValue(cv cty.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if typ == nil || typ.Is(tftypes.DynamicPseudoType) {
+		if !cv.IsKnown() && cv.Type() == cty.DynamicPseudoType {
+			return tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue), nil
+		}
+		if cv.IsNull() && cv.Type() == cty.DynamicPseudoType {
+			return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
+		}
+		var err error
+		if typ, err = CtyTypeToTerraformType(cv.Type()); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+
+	if !cv.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+	if cv.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	ct := cv.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		if ct != cty.String {
+			break
+		}
+		return tftypes.NewValue(typ, cv.AsString()), nil
+
+	case typ.Is(tftypes.Number):
+		if ct != cty.Number {
+			break
+		}
+		return tftypes.NewValue(typ, cv.AsBigFloat()), nil
+
+	case typ.Is(tftypes.Bool):
+		if ct != cty.Bool {
+			break
+		}
+		return tftypes.NewValue(typ, cv.True()), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		if !ct.IsListType() && !ct.IsSetType() && !ct.IsTupleType() {
+			break
+		}
+		elems := make([]tftypes.Value, 0, cv.LengthInt())
+		for it := cv.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			i := len(elems)
+			var et tftypes.Type
+			switch t := typ.(type) {
+			case tftypes.List:
+				et = t.ElementType
+			case tftypes.Set:
+				et = t.ElementType
+			case tftypes.Tuple:
+				if i >= len(t.ElementTypes) {
+					return tftypes.Value{}, fmt.Errorf("tuple has more than %d elements", len(t.ElementTypes))
+				}
+				et = t.ElementTypes[i]
+			}
+			tv, err := CtyValueToTerraformValue(ev, et)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elems = append(elems, tv)
+		}
+		if tt, ok := typ.(tftypes.Tuple); ok && len(elems) != len(tt.ElementTypes) {
+			return tftypes.Value{}, fmt.Errorf("expected %d tuple elements, saw %d", len(tt.ElementTypes), len(elems))
+		}
+		return tftypes.NewValue(typ, elems), nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		if !ct.IsMapType() && !ct.IsObjectType() {
+			break
+		}
+		elems := make(map[string]tftypes.Value, cv.LengthInt())
+		for it := cv.ElementIterator(); it.Next(); {
+			kv, ev := it.Element()
+			k := kv.AsString()
+			var et tftypes.Type
+			switch t := typ.(type) {
+			case tftypes.Map:
+				et = t.ElementType
+			case tftypes.Object:
+				var ok bool
+				if et, ok = t.AttributeTypes[k]; !ok {
+					return tftypes.Value{}, fmt.Errorf("attribute %q is not defined by %s", k, typ)
+				}
+			}
+			tv, err := CtyValueToTerraformValue(ev, et)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+			}
+			elems[k] = tv
+		}
+		if ot, ok := typ.(tftypes.Object); ok {
+			for k, at := range ot.AttributeTypes {
+				if _, ok := elems[k]; !ok {
+					elems[k] = tftypes.NewValue(at, nil)
+				}
+			}
+		}
+		return tftypes.NewValue(typ, elems), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("cannot convert cty.Value of type %s to %s", cv.Type().FriendlyName(), typ)
+}
+
+// SortedKeys returns the keys of the provided map in ascending order
+func SortedKeys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
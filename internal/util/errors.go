@@ -2,6 +2,9 @@ package util
 
 import "errors"
 
+// ErrUnknownValue is returned when a conversion encounters an unknown value it cannot represent
+var ErrUnknownValue = errors.New("cannot convert unknown value")
+
 func MatchError(src, tgt error) bool {
 	for src != nil {
 		if errors.Is(src, tgt) {
@@ -0,0 +1,156 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TerraformValueToJSON encodes the provided tftypes.Value as JSON in the form accepted by tftypes.ValueFromJSON for the
+// provided type.  Null values are encoded as JSON null, numbers are encoded without loss of precision, and values
+// whose type is tftypes.DynamicPseudoType are wrapped in an object containing their "type" and "value".
+//
+// An error wrapping ErrUnknownValue is returned if the value, or any value within it, is unknown.
+func TerraformValueToJSON(tv tftypes.Value, typ tftypes.Type) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := writeTerraformValueJSON(buf, tftypes.NewAttributePath(), tv, typ); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeTerraformValueJSON(buf *bytes.Buffer, p *tftypes.AttributePath, tv tftypes.Value, typ tftypes.Type) error {
+	if !tv.IsKnown() {
+		return p.NewError(ErrUnknownValue)
+	}
+	if tv.IsNull() {
+		buf.WriteString("null")
+		return nil
+	}
+
+	if typ == nil || typ.Is(tftypes.DynamicPseudoType) {
+		tb, err := tv.Type().MarshalJSON()
+		if err != nil {
+			return p.NewError(err)
+		}
+		buf.WriteString(`{"type":`)
+		buf.Write(tb)
+		buf.WriteString(`,"value":`)
+		if err = writeTerraformValueJSON(buf, p, tv, tv.Type()); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+		return nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := tv.As(&s); err != nil {
+			return p.NewError(err)
+		}
+		b, err := json.Marshal(s)
+		if err != nil {
+			return p.NewError(err)
+		}
+		buf.Write(b)
+		return nil
+
+	case typ.Is(tftypes.Number):
+		bf := new(big.Float)
+		if err := tv.As(&bf); err != nil {
+			return p.NewError(err)
+		}
+		if bf.IsInf() {
+			return p.NewErrorf("cannot encode %s as JSON", bf.String())
+		}
+		if bf.IsInt() {
+			buf.WriteString(bf.Text('f', 0))
+		} else {
+			buf.WriteString(bf.Text('g', -1))
+		}
+		return nil
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := tv.As(&b); err != nil {
+			return p.NewError(err)
+		}
+		if b {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+		return nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := tv.As(&elems); err != nil {
+			return p.NewError(err)
+		}
+		buf.WriteByte('[')
+		for i, elem := range elems {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			var (
+				et tftypes.Type
+				ep *tftypes.AttributePath
+			)
+			switch t := typ.(type) {
+			case tftypes.List:
+				et, ep = t.ElementType, p.WithElementKeyInt(i)
+			case tftypes.Set:
+				et, ep = t.ElementType, p.WithElementKeyValue(elem)
+			case tftypes.Tuple:
+				if i >= len(t.ElementTypes) {
+					return p.NewErrorf("tuple has more than %d elements", len(t.ElementTypes))
+				}
+				et, ep = t.ElementTypes[i], p.WithElementKeyInt(i)
+			}
+			if err := writeTerraformValueJSON(buf, ep, elem, et); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := tv.As(&elems); err != nil {
+			return p.NewError(err)
+		}
+		buf.WriteByte('{')
+		for i, k := range SortedKeys(elems) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			kb, err := json.Marshal(k)
+			if err != nil {
+				return p.NewError(err)
+			}
+			buf.Write(kb)
+			buf.WriteByte(':')
+
+			var (
+				et tftypes.Type
+				ep *tftypes.AttributePath
+			)
+			switch t := typ.(type) {
+			case tftypes.Map:
+				et, ep = t.ElementType, p.WithElementKeyString(k)
+			case tftypes.Object:
+				et, ep = t.AttributeTypes[k], p.WithAttributeName(k)
+			}
+			if err = writeTerraformValueJSON(buf, ep, elems[k], et); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	}
+
+	return p.NewErrorf("cannot encode value of type %s as JSON", typ)
+}
@@ -0,0 +1,294 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// StructTag is the struct tag used to map struct fields to object attributes
+const StructTag = "tfsdk"
+
+var (
+	attrValueType  = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+)
+
+// StructFields returns the index of each field in the provided struct type with a tfsdk tag, keyed by the tag's value.
+// Fields without a tag, or tagged with "-", are ignored.
+func StructFields(t reflect.Type) (map[string]int, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", t)
+	}
+	out := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get(StructTag), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("%s.%s: tagged field must be exported", t, f.Name)
+		}
+		if _, ok := out[name]; ok {
+			return nil, fmt.Errorf("%s.%s: duplicate %s tag %q", t, f.Name, StructTag, name)
+		}
+		out[name] = i
+	}
+	return out, nil
+}
+
+// AttrTypeOf derives the attr.Type a value of the provided Go type converts to.  Pointers are dereferenced, slices and
+// arrays become lists, string-keyed maps become maps, and structs become objects with an attribute per tfsdk-tagged
+// field.  Fields which are themselves attr.Value implementations use the value's own type, which for collection and
+// object types such as types.List cannot be derived without a value and results in an error.
+func AttrTypeOf(t reflect.Type) (attr.Type, error) {
+	return attrTypeOf(t, make(map[reflect.Type]bool))
+}
+
+func attrTypeOf(t reflect.Type, visiting map[reflect.Type]bool) (attr.Type, error) {
+	if t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface && t.Implements(attrValueType) {
+		typ := reflect.Zero(t).Interface().(attr.Value).Type(context.Background())
+		if !AttrTypeIsComplete(typ) {
+			return nil, fmt.Errorf("cannot derive attr.Type of %s: its element or attribute types are only known from a value", t)
+		}
+		return typ, nil
+	}
+
+	switch t {
+	case bigFloatType, bigIntType, jsonNumberType:
+		return types.NumberType, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return attrTypeOf(t.Elem(), visiting)
+
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return types.DynamicType, nil
+		}
+
+	case reflect.String:
+		return types.StringType, nil
+
+	case reflect.Bool:
+		return types.BoolType, nil
+
+	case reflect.Int32:
+		return types.Int32Type, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return types.Int64Type, nil
+
+	case reflect.Float32:
+		return types.Float32Type, nil
+
+	case reflect.Float64:
+		return types.Float64Type, nil
+
+	case reflect.Slice, reflect.Array:
+		et, err := attrTypeOf(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return types.ListType{ElemType: et}, nil
+
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			break
+		}
+		et, err := attrTypeOf(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return types.MapType{ElemType: et}, nil
+
+	case reflect.Struct:
+		if visiting[t] {
+			return nil, fmt.Errorf("%s is recursive", t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		fields, err := StructFields(t)
+		if err != nil {
+			return nil, err
+		}
+		attrTypes := make(map[string]attr.Type, len(fields))
+		for name, idx := range fields {
+			at, err := attrTypeOf(t.Field(idx).Type, visiting)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			attrTypes[name] = at
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	return nil, fmt.Errorf("cannot derive attr.Type of %s", t)
+}
+
+// AttrTypeOfValue derives the attr.Type of the provided value as AttrTypeOf does, except that struct fields, pointers,
+// and the elements of slices, arrays, and string-keyed maps are inspected so that attr.Value fields, including
+// collection and object types, use the type of the value they hold.  The element type of a collection is derived from
+// its first element, or its first key in sorted order for maps.  Empty collections, nil pointers, and nil slices and
+// maps fall back to AttrTypeOf, which results in an error if their element type contains a collection or object
+// attr.Value.
+func AttrTypeOfValue(rv reflect.Value) (attr.Type, error) {
+	return attrTypeOfValue(rv, make(map[reflect.Type]bool))
+}
+
+func attrTypeOfValue(rv reflect.Value, visiting map[reflect.Type]bool) (attr.Type, error) {
+	t := rv.Type()
+	if t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface && t.Implements(attrValueType) {
+		typ := rv.Interface().(attr.Value).Type(context.Background())
+		if !AttrTypeIsComplete(typ) {
+			return nil, fmt.Errorf("%s value is missing its element or attribute types", t)
+		}
+		return typ, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return attrTypeOf(t, visiting)
+		}
+		return attrTypeOfValue(rv.Elem(), visiting)
+
+	case reflect.Slice, reflect.Array:
+		if rv.Len() == 0 {
+			return attrTypeOf(t, visiting)
+		}
+		et, err := attrTypeOfValue(rv.Index(0), visiting)
+		if err != nil {
+			return nil, fmt.Errorf("[0]: %w", err)
+		}
+		return types.ListType{ElemType: et}, nil
+
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String || rv.Len() == 0 {
+			return attrTypeOf(t, visiting)
+		}
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		et, err := attrTypeOfValue(rv.MapIndex(keys[0]), visiting)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", keys[0].String(), err)
+		}
+		return types.MapType{ElemType: et}, nil
+
+	case reflect.Struct:
+		switch t {
+		case bigFloatType, bigIntType:
+			return types.NumberType, nil
+		}
+		if visiting[t] {
+			return nil, fmt.Errorf("%s is recursive", t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		fields, err := StructFields(t)
+		if err != nil {
+			return nil, err
+		}
+		attrTypes := make(map[string]attr.Type, len(fields))
+		for name, idx := range fields {
+			at, err := attrTypeOfValue(rv.Field(idx), visiting)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			attrTypes[name] = at
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	return attrTypeOf(t, visiting)
+}
+
+// missingAttrType is the placeholder type reported by collection types without an element type
+var missingAttrType = types.ListType{}.ElementType()
+
+// AttrTypeIsComplete returns false if the provided type, or any type within it, is missing element or attribute
+// types, as is the case for the type of the zero value of types.List, types.Set, types.Map, types.Object and
+// types.Tuple.  As the zero values of the latter two are indistinguishable from empty ones, object and tuple types
+// without attributes or elements are also considered incomplete.
+func AttrTypeIsComplete(typ attr.Type) bool {
+	if typ == nil || missingAttrType.Equal(typ) {
+		return false
+	}
+	switch t := typ.(type) {
+	case attr.TypeWithElementType:
+		return AttrTypeIsComplete(t.ElementType())
+	case attr.TypeWithAttributeTypes:
+		if len(t.AttributeTypes()) == 0 {
+			return false
+		}
+		for _, at := range t.AttributeTypes() {
+			if !AttrTypeIsComplete(at) {
+				return false
+			}
+		}
+	case attr.TypeWithElementTypes:
+		if len(t.ElementTypes()) == 0 {
+			return false
+		}
+		for _, et := range t.ElementTypes() {
+			if !AttrTypeIsComplete(et) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func structToTerraformValue(ctx context.Context, typ tftypes.Object, rv reflect.Value) (tftypes.Value, error) {
+	fields, err := StructFields(rv.Type())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, idx := range fields {
+		at, ok := typ.AttributeTypes[name]
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("%s: attribute is not defined by type %s", name, typ)
+		}
+		av, err := GoToTerraformValue(ctx, at, rv.Field(idx).Interface())
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+		attrs[name] = av
+	}
+	for name, at := range typ.AttributeTypes {
+		if _, ok := attrs[name]; !ok {
+			attrs[name] = tftypes.NewValue(at, nil)
+		}
+	}
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+func terraformValueIntoStruct(attrs map[string]tftypes.Value, target reflect.Value) error {
+	fields, err := StructFields(target.Type())
+	if err != nil {
+		return err
+	}
+	for name, av := range attrs {
+		idx, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("%s: attribute has no corresponding field in %s", name, target.Type())
+		}
+		if err := terraformValueInto(av, target.Field(idx)); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,553 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TerraformValueToJSONable converts the provided tftypes.Value into a value that may be passed to json.Marshal.
+// Numbers are converted to json.Number so that no precision is lost, and null values are converted to nil.  An error
+// is returned if the value, or any value within it, is unknown.
+func TerraformValueToJSONable(v tftypes.Value) (interface{}, error) {
+	if !v.IsFullyKnown() {
+		return nil, fmt.Errorf("%w: type=%s", ErrUnknownValue, v.Type())
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+
+	case typ.Is(tftypes.Number):
+		bf := new(big.Float)
+		if err := v.As(&bf); err != nil {
+			return nil, err
+		}
+		return json.Number(bf.Text('g', -1)), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, len(elems))
+		for i, elem := range elems {
+			ev, err := TerraformValueToJSONable(elem)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			out[i] = ev
+		}
+		return out, nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		out := make(map[string]interface{}, len(elems))
+		for k, elem := range elems {
+			ev, err := TerraformValueToJSONable(elem)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			out[k] = ev
+		}
+		return out, nil
+
+	default:
+		return nil, fmt.Errorf("unhandled tftypes type %s", typ)
+	}
+}
+
+// GoToTerraformValue converts the provided Go value into a tftypes.Value of the provided type.  Nil values, including
+// nil pointers, slices, and maps, are converted to null.  attr.Value and tftypes.Value inputs are converted as-is.  If typ is
+// tftypes.DynamicPseudoType, the type is inferred from the value with InferTerraformType.
+func GoToTerraformValue(ctx context.Context, typ tftypes.Type, in interface{}) (tftypes.Value, error) {
+	switch v := in.(type) {
+	case nil:
+		return tftypes.NewValue(typ, nil), nil
+	case tftypes.Value:
+		return v, nil
+	case attr.Value:
+		return v.ToTerraformValue(ctx)
+	}
+
+	if typ.Is(tftypes.DynamicPseudoType) {
+		inferred, err := InferTerraformType(ctx, in)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		typ = inferred
+	}
+
+	rv := reflect.ValueOf(in)
+	for rv.Kind() == reflect.Pointer && !isBigNumber(rv.Interface()) {
+		if rv.IsNil() {
+			return tftypes.NewValue(typ, nil), nil
+		}
+		rv = rv.Elem()
+	}
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.IsNil() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		if rv.Kind() == reflect.String {
+			return tftypes.NewValue(typ, rv.String()), nil
+		}
+
+	case typ.Is(tftypes.Bool):
+		if rv.Kind() == reflect.Bool {
+			return tftypes.NewValue(typ, rv.Bool()), nil
+		}
+
+	case typ.Is(tftypes.Number):
+		if bf, ok := goNumberToBigFloat(rv); ok {
+			return tftypes.NewValue(typ, bf), nil
+		}
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+			var elemType tftypes.Type
+			if lt, ok := typ.(tftypes.List); ok {
+				elemType = lt.ElementType
+			} else {
+				elemType = typ.(tftypes.Set).ElementType
+			}
+			elems := make([]tftypes.Value, rv.Len())
+			for i := range elems {
+				ev, err := GoToTerraformValue(ctx, elemType, rv.Index(i).Interface())
+				if err != nil {
+					return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+				}
+				elems[i] = ev
+			}
+			return tftypes.NewValue(typ, elems), nil
+		}
+
+	case typ.Is(tftypes.Tuple{}):
+		elemTypes := typ.(tftypes.Tuple).ElementTypes
+		if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Len() == len(elemTypes) {
+			elems := make([]tftypes.Value, rv.Len())
+			for i := range elems {
+				ev, err := GoToTerraformValue(ctx, elemTypes[i], rv.Index(i).Interface())
+				if err != nil {
+					return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+				}
+				elems[i] = ev
+			}
+			return tftypes.NewValue(typ, elems), nil
+		}
+
+	case typ.Is(tftypes.Map{}):
+		if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
+			elemType := typ.(tftypes.Map).ElementType
+			elems := make(map[string]tftypes.Value, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				k := iter.Key().String()
+				ev, err := GoToTerraformValue(ctx, elemType, iter.Value().Interface())
+				if err != nil {
+					return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+				}
+				elems[k] = ev
+			}
+			return tftypes.NewValue(typ, elems), nil
+		}
+
+	case typ.Is(tftypes.Object{}):
+		if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
+			attrTypes := typ.(tftypes.Object).AttributeTypes
+			attrs := make(map[string]tftypes.Value, len(attrTypes))
+			iter := rv.MapRange()
+			for iter.Next() {
+				k := iter.Key().String()
+				at, ok := attrTypes[k]
+				if !ok {
+					return tftypes.Value{}, fmt.Errorf("%s: attribute is not defined by type %s", k, typ)
+				}
+				av, err := GoToTerraformValue(ctx, at, iter.Value().Interface())
+				if err != nil {
+					return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+				}
+				attrs[k] = av
+			}
+			for k, at := range attrTypes {
+				if _, ok := attrs[k]; !ok {
+					attrs[k] = tftypes.NewValue(at, nil)
+				}
+			}
+			return tftypes.NewValue(typ, attrs), nil
+		}
+		if rv.Kind() == reflect.Struct {
+			return structToTerraformValue(ctx, typ.(tftypes.Object), rv)
+		}
+	}
+
+	return tftypes.Value{}, fmt.Errorf("cannot convert %T to %s", in, typ)
+}
+
+// InferTerraformType returns the tftypes.Type a Go value would naturally convert to.  Slices and arrays are inferred as
+// tuples, and string-keyed maps as objects, so that their elements may each be of a different type.
+func InferTerraformType(ctx context.Context, in interface{}) (tftypes.Type, error) {
+	switch v := in.(type) {
+	case nil:
+		return tftypes.DynamicPseudoType, nil
+	case tftypes.Value:
+		return v.Type(), nil
+	case attr.Value:
+		return v.Type(ctx).TerraformType(ctx), nil
+	}
+
+	if isBigNumber(in) {
+		return tftypes.Number, nil
+	}
+
+	rv := reflect.ValueOf(in)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return tftypes.DynamicPseudoType, nil
+		}
+		return InferTerraformType(ctx, rv.Elem().Interface())
+
+	case reflect.String:
+		return tftypes.String, nil
+
+	case reflect.Bool:
+		return tftypes.Bool, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return tftypes.Number, nil
+
+	case reflect.Slice, reflect.Array:
+		elemTypes := make([]tftypes.Type, rv.Len())
+		for i := range elemTypes {
+			et, err := InferTerraformType(ctx, rv.Index(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elemTypes[i] = et
+		}
+		return tftypes.Tuple{ElementTypes: elemTypes}, nil
+
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		attrTypes := make(map[string]tftypes.Type, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := iter.Key().String()
+			at, err := InferTerraformType(ctx, iter.Value().Interface())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k] = at
+		}
+		return tftypes.Object{AttributeTypes: attrTypes}, nil
+	}
+
+	return nil, fmt.Errorf("cannot infer terraform type of %T", in)
+}
+
+func isBigNumber(in interface{}) bool {
+	switch in.(type) {
+	case *big.Float, *big.Int, big.Float, big.Int, json.Number:
+		return true
+	default:
+		return false
+	}
+}
+
+// goNumberToBigFloat converts numeric kinds, along with the big and json.Number types, to *big.Float
+func goNumberToBigFloat(rv reflect.Value) (*big.Float, bool) {
+	switch v := rv.Interface().(type) {
+	case *big.Float:
+		return v, true
+	case big.Float:
+		return &v, true
+	case *big.Int:
+		return new(big.Float).SetInt(v), true
+	case big.Int:
+		return new(big.Float).SetInt(&v), true
+	case json.Number:
+		bf, _, err := big.ParseFloat(string(v), 10, 512, big.ToNearestEven)
+		return bf, err == nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(rv.Float()) {
+			return nil, false
+		}
+		return big.NewFloat(rv.Float()), true
+	default:
+		return nil, false
+	}
+}
+
+var (
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigIntType   = reflect.TypeOf(big.Int{})
+)
+
+// TerraformValueInto sets the value pointed to by target to the Go representation of the provided tftypes.Value.
+// Pointers are allocated as needed, and are left nil for null values.  Null values set non-pointer targets to their
+// zero value.  An error is returned if the value, or any value within it, is unknown or cannot be represented by the
+// target's type without loss.
+func TerraformValueInto(v tftypes.Value, target interface{}) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("target must be a non-nil pointer, saw %T", target)
+	}
+	return terraformValueInto(v, rv.Elem())
+}
+
+func terraformValueInto(v tftypes.Value, target reflect.Value) error {
+	// attr.Value targets may hold null and unknown values themselves
+	if target.Kind() != reflect.Interface && target.Kind() != reflect.Pointer && target.Type().Implements(attrValueType) {
+		ctx := context.Background()
+		typ := reflect.Zero(target.Type()).Interface().(attr.Value).Type(ctx)
+		if !AttrTypeIsComplete(typ) {
+			// the zero value of collection and object types, e.g. types.List, does not know its element types
+			var err error
+			if typ, err = basetypes.TerraformTypeToFrameworkType(v.Type()); err != nil {
+				return err
+			}
+		}
+		av, err := typ.ValueFromTerraform(ctx, v)
+		if err != nil {
+			return err
+		}
+		rav := reflect.ValueOf(av)
+		if !rav.Type().ConvertibleTo(target.Type()) {
+			return fmt.Errorf("cannot convert %s to %s", rav.Type(), target.Type())
+		}
+		target.Set(rav.Convert(target.Type()))
+		return nil
+	}
+
+	if target.Kind() == reflect.Pointer {
+		if v.IsKnown() && v.IsNull() {
+			target.SetZero()
+			return nil
+		}
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return terraformValueInto(v, target.Elem())
+	}
+
+	if !v.IsKnown() {
+		return fmt.Errorf("%w: type=%s", ErrUnknownValue, v.Type())
+	}
+
+	if v.IsNull() {
+		target.SetZero()
+		return nil
+	}
+
+	if target.Kind() == reflect.Interface && target.NumMethod() == 0 {
+		gv, err := terraformValueToInterface(v)
+		if err != nil {
+			return err
+		}
+		if gv != nil {
+			target.Set(reflect.ValueOf(gv))
+		} else {
+			target.SetZero()
+		}
+		return nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		if target.Kind() == reflect.String {
+			var s string
+			if err := v.As(&s); err != nil {
+				return err
+			}
+			target.SetString(s)
+			return nil
+		}
+
+	case typ.Is(tftypes.Bool):
+		if target.Kind() == reflect.Bool {
+			var b bool
+			if err := v.As(&b); err != nil {
+				return err
+			}
+			target.SetBool(b)
+			return nil
+		}
+
+	case typ.Is(tftypes.Number):
+		bf := new(big.Float)
+		if err := v.As(&bf); err != nil {
+			return err
+		}
+		return bigFloatInto(bf, target)
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return err
+		}
+		switch target.Kind() {
+		case reflect.Slice:
+			out := reflect.MakeSlice(target.Type(), len(elems), len(elems))
+			for i, elem := range elems {
+				if err := terraformValueInto(elem, out.Index(i)); err != nil {
+					return fmt.Errorf("[%d]: %w", i, err)
+				}
+			}
+			target.Set(out)
+			return nil
+		case reflect.Array:
+			if target.Len() != len(elems) {
+				return fmt.Errorf("cannot convert %s with %d elements to %s", typ, len(elems), target.Type())
+			}
+			for i, elem := range elems {
+				if err := terraformValueInto(elem, target.Index(i)); err != nil {
+					return fmt.Errorf("[%d]: %w", i, err)
+				}
+			}
+			return nil
+		}
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return err
+		}
+		if target.Kind() == reflect.Map && target.Type().Key().Kind() == reflect.String {
+			out := reflect.MakeMapWithSize(target.Type(), len(elems))
+			for k, elem := range elems {
+				ev := reflect.New(target.Type().Elem()).Elem()
+				if err := terraformValueInto(elem, ev); err != nil {
+					return fmt.Errorf("%s: %w", k, err)
+				}
+				out.SetMapIndex(reflect.ValueOf(k).Convert(target.Type().Key()), ev)
+			}
+			target.Set(out)
+			return nil
+		}
+		if target.Kind() == reflect.Struct {
+			return terraformValueIntoStruct(elems, target)
+		}
+	}
+
+	return fmt.Errorf("cannot convert %s to %s", typ, target.Type())
+}
+
+// bigFloatInto sets target to the provided number, returning an error if the target's type cannot hold it without
+// overflow, or for integer types, without truncation
+func bigFloatInto(bf *big.Float, target reflect.Value) error {
+	switch target.Type() {
+	case bigFloatType:
+		target.Set(reflect.ValueOf(*new(big.Float).Copy(bf)))
+		return nil
+	case bigIntType:
+		if !bf.IsInt() {
+			return fmt.Errorf("cannot convert %s to %s without truncation", bf.Text('g', -1), target.Type())
+		}
+		bi, _ := bf.Int(nil)
+		target.Set(reflect.ValueOf(*bi))
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !bf.IsInt() {
+			return fmt.Errorf("cannot convert %s to %s without truncation", bf.Text('g', -1), target.Type())
+		}
+		i, acc := bf.Int64()
+		if acc != big.Exact || target.OverflowInt(i) {
+			return fmt.Errorf("%s overflows %s", bf.Text('g', -1), target.Type())
+		}
+		target.SetInt(i)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !bf.IsInt() {
+			return fmt.Errorf("cannot convert %s to %s without truncation", bf.Text('g', -1), target.Type())
+		}
+		u, acc := bf.Uint64()
+		if acc != big.Exact || target.OverflowUint(u) {
+			return fmt.Errorf("%s overflows %s", bf.Text('g', -1), target.Type())
+		}
+		target.SetUint(u)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		f, _ := bf.Float64()
+		if math.IsInf(f, 0) || target.OverflowFloat(f) {
+			return fmt.Errorf("%s overflows %s", bf.Text('g', -1), target.Type())
+		}
+		target.SetFloat(f)
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %s to %s", tftypes.Number, target.Type())
+}
+
+// terraformValueToInterface returns the natural Go representation of the provided value: string, bool, *big.Float,
+// []interface{}, or map[string]interface{}.  Null values are returned as nil.
+func terraformValueToInterface(v tftypes.Value) (interface{}, error) {
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("%w: type=%s", ErrUnknownValue, v.Type())
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.Number):
+		bf := new(big.Float)
+		err := v.As(&bf)
+		return bf, err
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		out := make([]interface{}, 0)
+		err := terraformValueInto(v, reflect.ValueOf(&out).Elem())
+		return out, err
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		out := make(map[string]interface{})
+		err := terraformValueInto(v, reflect.ValueOf(&out).Elem())
+		return out, err
+
+	default:
+		// strings and bools
+		return TerraformValueToJSONable(v)
+	}
+}
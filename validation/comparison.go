@@ -559,26 +559,37 @@ func init() {
 // If there is no comparison function registered for the target type, an ErrNoComparisonFuncRegistered
 // is returned.
 //
-// If a function is registered and the comparison fails, an ErrComparisonFailed error will be returned
+// If a function is registered and the comparison fails, an ErrComparisonFailed error will be returned.
+//
+// Pointers and custom types implementing the basetypes Valuable interfaces are converted to their base type, and
+// types.Dynamic values to their concrete value, before being passed to the comparison function.
 func CompareAttrValues(ctx context.Context, av attr.Value, op CompareOp, target interface{}, meta ...interface{}) error {
 	if fn, ok := GetComparisonFunc(target); ok {
-		return fn(ctx, av, op, target, meta...)
+		bv, err := concreteAttrValue(av)
+		if err != nil {
+			return TypeConversionFailedError(err)
+		}
+		return fn(ctx, bv, op, target, meta...)
 	} else {
 		return fmt.Errorf("%w for operation %q with target type %T", ErrNoComparisonFuncRegistered, op, target)
 	}
 }
 
-func addComparisonFailedDiagnostic(op CompareOp, target interface{}, srcReq interface{}, srcResp interface{}, err error) {
-	var (
-		req  GenericRequest
-		resp *GenericResponse
-		terr error
-	)
-
-	if req, resp, terr = toGenericTypes(srcReq, srcResp); terr != nil {
-		panic(terr.Error())
+// concreteAttrValue returns the base type literal of av, unwrapping types.Dynamic values that hold a concrete value
+func concreteAttrValue(av attr.Value) (attr.Value, error) {
+	bv, err := conv.TryToBaseValue(av)
+	if err != nil {
+		return nil, err
 	}
+	if _, ok := bv.(types.Dynamic); ok {
+		if uv, err := conv.TryDynamicToUnderlyingValue(bv); err == nil {
+			return uv, nil
+		}
+	}
+	return bv, nil
+}
 
+func addComparisonFailedDiagnostic(op CompareOp, target interface{}, req GenericRequest, resp *GenericResponse, err error) {
 	switch op {
 	case Equal:
 		resp.Diagnostics.AddAttributeError(
@@ -8,8 +8,13 @@ import (
 	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+type customString struct {
+	basetypes.StringValue
+}
+
 type comparisonTest struct {
 	name        string
 	op          validation.CompareOp
@@ -462,6 +467,49 @@ func TestComparison_String(t *testing.T) {
 	}
 }
 
+func TestComparison_CustomType(t *testing.T) {
+	theTests := []comparisonTest{
+		{
+			name: "eq_ok",
+			op:   validation.Equal,
+			act:  customString{types.StringValue("hi")},
+			tgt:  "hi",
+		},
+		{
+			name:        "eq_nok",
+			op:          validation.Equal,
+			act:         customString{types.StringValue("hi")},
+			tgt:         "ih",
+			expectError: true,
+		},
+		{
+			name: "one_of_ok",
+			op:   validation.OneOf,
+			act:  &customString{types.StringValue("hi")},
+			tgt:  []string{"hello", "hi"},
+		},
+		{
+			name: "dynamic_eq_ok",
+			op:   validation.Equal,
+			act:  types.DynamicValue(types.Int64Value(5)),
+			tgt:  5,
+		},
+		{
+			name:        "dynamic_gt_nok",
+			op:          validation.GreaterThan,
+			act:         types.DynamicValue(types.Int64Value(5)),
+			tgt:         5,
+			expectError: true,
+		},
+	}
+
+	for _, ct := range theTests {
+		t.Run(ct.name, func(t *testing.T) {
+			ct.do(t)
+		})
+	}
+}
+
 func TestComparison_Strings(t *testing.T) {
 	const (
 		one   = "one"
@@ -64,6 +64,11 @@ func (r GenericRequest) StringRequest() (validator.StringRequest, bool) {
 	return sr, ok
 }
 
+func (r GenericRequest) DynamicRequest() (validator.DynamicRequest, bool) {
+	dr, ok := r.source.(validator.DynamicRequest)
+	return dr, ok
+}
+
 func toGenericRequest(src interface{}) (GenericRequest, error) {
 	var (
 		err error
@@ -118,6 +123,11 @@ func toGenericRequest(src interface{}) (GenericRequest, error) {
 		req.PathExpression = sr.PathExpression
 		req.Config = sr.Config
 		req.ConfigValue = sr.ConfigValue
+	} else if dr, ok := src.(validator.DynamicRequest); ok {
+		req.Path = dr.Path
+		req.PathExpression = dr.PathExpression
+		req.Config = dr.Config
+		req.ConfigValue = dr.ConfigValue
 	} else {
 		err = fmt.Errorf("unknown validator request type %T seen", src)
 	}
@@ -182,6 +192,11 @@ func (r *GenericResponse) StringResponse() (*validator.StringResponse, bool) {
 	return sr, ok
 }
 
+func (r *GenericResponse) DynamicResponse() (*validator.DynamicResponse, bool) {
+	dr, ok := r.source.(*validator.DynamicResponse)
+	return dr, ok
+}
+
 func toGenericResponse(src interface{}) (*GenericResponse, error) {
 	var (
 		err error
@@ -236,6 +251,11 @@ func toGenericResponse(src interface{}) (*GenericResponse, error) {
 		if sr != nil {
 			resp.Diagnostics = sr.Diagnostics
 		}
+	} else if dr, ok := src.(*validator.DynamicResponse); ok {
+		resp.nil = dr == nil
+		if dr != nil {
+			resp.Diagnostics = dr.Diagnostics
+		}
 	} else {
 		err = fmt.Errorf("unknown validator response type: %T", src)
 	}
@@ -92,6 +92,7 @@ func (g Generic) ValidateBool(ctx context.Context, req validator.BoolRequest, re
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
@@ -101,6 +102,7 @@ func (g Generic) ValidateFloat64(ctx context.Context, req validator.Float64Reque
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
@@ -110,6 +112,7 @@ func (g Generic) ValidateInt64(ctx context.Context, req validator.Int64Request,
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
@@ -119,6 +122,7 @@ func (g Generic) ValidateList(ctx context.Context, req validator.ListRequest, re
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
@@ -128,6 +132,7 @@ func (g Generic) ValidateMap(ctx context.Context, req validator.MapRequest, resp
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
@@ -137,6 +142,7 @@ func (g Generic) ValidateNumber(ctx context.Context, req validator.NumberRequest
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
@@ -146,6 +152,7 @@ func (g Generic) ValidateObject(ctx context.Context, req validator.ObjectRequest
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
@@ -155,6 +162,7 @@ func (g Generic) ValidateSet(ctx context.Context, req validator.SetRequest, resp
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 func (g Generic) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
@@ -164,6 +172,17 @@ func (g Generic) ValidateString(ctx context.Context, req validator.StringRequest
 		return
 	}
 	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
+}
+
+func (g Generic) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	rq, rp, err := toGenericTypes(req, resp)
+	if err != nil {
+		resp.Diagnostics.AddError("conversion error", err.Error())
+		return
+	}
+	g.Validate(ctx, rq, rp)
+	resp.Diagnostics = rp.Diagnostics
 }
 
 // RequiredTest is an Generic implementation that will register an error if the attribute has no value of
@@ -393,15 +412,24 @@ func TestIsURL(requireScheme string, requirePort int) TestFunc {
 			}
 		}
 
-		if lv, ok := req.ConfigValue.(types.List); ok {
+		cv, err := concreteAttrValue(req.ConfigValue)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Could not convert attribute value",
+				fmt.Sprintf("Unable to convert attribute value type %T to its base type: %v", req.ConfigValue, err),
+			)
+			return
+		}
+		if lv, ok := cv.(types.List); ok {
 			for _, v := range lv.Elements() {
 				validateURL(conv.AttributeValueToString(v))
 			}
-		} else if sv, ok := req.ConfigValue.(types.Set); ok {
+		} else if sv, ok := cv.(types.Set); ok {
 			for _, v := range sv.Elements() {
 				validateURL(conv.AttributeValueToString(v))
 			}
-		} else if mv, ok := req.ConfigValue.(types.Map); ok {
+		} else if mv, ok := cv.(types.Map); ok {
 			for _, v := range mv.Elements() {
 				validateURL(conv.AttributeValueToString(v))
 			}
@@ -0,0 +1,59 @@
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+)
+
+func TestValidateDynamic(t *testing.T) {
+	theTests := []struct {
+		name        string
+		validator   validation.Generic
+		value       types.Dynamic
+		expectError bool
+	}{
+		{name: "length-ok", validator: validation.Length(1, 3), value: types.DynamicValue(types.StringValue("ab"))},
+		{name: "length-too-long", validator: validation.Length(1, 3), value: types.DynamicValue(types.StringValue("abcd")), expectError: true},
+		{name: "length-skip-null", validator: validation.Length(1, 3), value: types.DynamicNull()},
+		{name: "length-test-null", validator: validation.NewGenericValidator(validation.GenericConfig{TestFunc: validation.LengthTest(1, -1)}), value: types.DynamicNull(), expectError: true},
+		{name: "length-test-unknown", validator: validation.NewGenericValidator(validation.GenericConfig{TestFunc: validation.LengthTest(0, -1)}), value: types.DynamicUnknown()},
+		{name: "compare-ok", validator: validation.Compare(validation.Equal, "x"), value: types.DynamicValue(types.StringValue("x"))},
+		{name: "compare-failed", validator: validation.Compare(validation.Equal, "x"), value: types.DynamicValue(types.StringValue("y")), expectError: true},
+		{name: "required-null", validator: validation.Required(), value: types.DynamicNull(), expectError: true},
+		{name: "required-valued", validator: validation.Required(), value: types.DynamicValue(types.BoolValue(true))},
+	}
+
+	for _, tt := range theTests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.DynamicRequest{Path: path.Root("test"), ConfigValue: tt.value}
+			resp := &validator.DynamicResponse{}
+			assert.NotPanics(t, func() { tt.validator.ValidateDynamic(context.Background(), req, resp) })
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestValidatorDiagnostics(t *testing.T) {
+	v := validation.Length(1, 2)
+
+	strResp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue("abc")}, strResp)
+	assert.True(t, strResp.Diagnostics.HasError(), "Expected string length diagnostic to reach the response")
+
+	strResp = &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue("ab")}, strResp)
+	assert.False(t, strResp.Diagnostics.HasError())
+
+	listResp := &validator.ListResponse{}
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b"), types.StringValue("c")})
+	v.ValidateList(context.Background(), validator.ListRequest{Path: path.Root("test"), ConfigValue: list}, listResp)
+	assert.True(t, listResp.Diagnostics.HasError(), "Expected list length diagnostic to reach the response")
+}